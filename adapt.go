@@ -0,0 +1,83 @@
+package middle
+
+import (
+	"errors"
+	"maps"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// ErrNotAdaptable is returned by functions created via [Adapt] if the middleware called the next handler with a different request, or response writer, but they can not be passed to the rest of the chain functions, as the function is not a function of a chain, e.g., it is called by another function, such as one created via [Sub2], [Switch], [Parallel2], or [Retry2], rather than by the chain itself, or the response writer passed to it is neither created by a chain, nor wraps one that is, according to [Recorder] semantics.
+var ErrNotAdaptable = errors.New("middleware changes can not be passed to the rest of the chain")
+
+// Adapt creates a function that executes a classic net/http middleware as a function of a chain. The function succeeds only if the middleware calls the next handler, in which case the rest of the chain functions receive the request, and the response writer the middleware called it with, e.g., a request with values added to its context, or a wrapped response writer. Otherwise, it returns [ErrAbort], as the middleware is expected to already have responded. It can be used at positions other than the first one via [Lift2], and the like, but not inside other functions of a chain, e.g., sub-chains created via [Sub2], in which case it returns [ErrNotAdaptable] if the middleware changes the request, or the response writer, as the changes would not reach the functions that are called after it.
+//
+// The next handler returns as soon as it is called, i.e., before the rest of the chain functions are executed, which makes it unsuitable for middlewares that do something after the next handler returns, e.g., measure the handler execution time, or close a compressing response writer.
+func Adapt(middleware func(http.Handler) http.Handler) func(http.ResponseWriter, *http.Request) (struct{}, error) {
+	return func(response http.ResponseWriter, request *http.Request) (struct{}, error) {
+		var (
+			called      bool
+			nextRequest *http.Request
+			nextWriter  http.ResponseWriter
+		)
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called, nextWriter, nextRequest = true, w, r
+		})
+		middleware(next).ServeHTTP(response, request)
+		if !called {
+			return struct{}{}, ErrAbort
+		}
+		if nextWriter == response && nextRequest == request {
+			return struct{}{}, nil
+		}
+		rec, ok := Recorder(response)
+		if !ok || nil == rec.exec || !rec.exec.adaptable(response, request) {
+			return struct{}{}, ErrNotAdaptable
+		}
+		rec.exec.replace(nextWriter, nextRequest)
+		return struct{}{}, nil
+	}
+}
+
+var (
+	// adaptName is the name functions created via [Adapt] are reported by.
+	adaptName = funcName(Adapt(nil))
+	// liftPrefix is the prefix of names of functions created via [Lift2], [LiftLast2], and the like.
+	liftPrefix = reflect.TypeOf(config{}).PkgPath() + ".Lift"
+)
+
+// adapting returns c with positions of functions created via [Adapt] among steps recorded, copying c first if they are not recorded already. Functions are only recorded if they are the chain functions themselves, or are wrapped via [Lift2], and the like, which pass the request, and the response writer to them as is.
+func (c *config) adapting(steps []StepInfo) *config {
+	var adapts map[int]bool
+	for i, step := range steps {
+		if step.Name != adaptName || !lifted(step.Wrappers) {
+			continue
+		}
+		if nil == adapts {
+			adapts = make(map[int]bool)
+		}
+		adapts[i+1] = true
+	}
+	if (nil == c && nil == adapts) || (nil != c && maps.Equal(c.adapts, adapts)) {
+		return c
+	}
+	c = c.with(nil)
+	c.adapts = adapts
+	return c
+}
+
+// lifted reports whether all of wrappers are functions created via [Lift2], and the like.
+func lifted(wrappers []string) bool {
+	for _, wrapper := range wrappers {
+		if !strings.HasPrefix(wrapper, liftPrefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// adaptable reports whether changes made by a middleware adapted via [Adapt] can be passed to the rest of the chain, i.e., the current function of the chain is created via [Adapt], and the middleware is called with the response writer, and request passed to it.
+func (exec *execution) adaptable(response http.ResponseWriter, request *http.Request) bool {
+	return nil != exec.config && exec.config.adapts[exec.step] && response == exec.response && request == exec.request
+}
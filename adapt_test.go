@@ -0,0 +1,160 @@
+package middle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type adaptKey struct{}
+
+// taggingWriter is a response writer a middleware wraps the response writer with.
+type taggingWriter struct {
+	http.ResponseWriter
+}
+
+func (w taggingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// tagging is a middleware that adds a value to the request context, and wraps the response writer.
+func tagging(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		next.ServeHTTP(taggingWriter{response}, request.WithContext(context.WithValue(request.Context(), adaptKey{}, "tagged")))
+	})
+}
+
+// tagged fails unless request, and response are the ones tagging passes to the next handler.
+func tagged(response http.ResponseWriter, request *http.Request) error {
+	if _, ok := response.(taggingWriter); !ok {
+		return errors.New("expected response writer to be wrapped")
+	}
+	if request.Context().Value(adaptKey{}) != "tagged" {
+		return errors.New("expected request context to hold the value")
+	}
+	return request.Context().Err()
+}
+
+// serveAdapted serves a request via chain, and returns the error its catch is called with, if any.
+func serveAdapted(chain interface {
+	Finally(func(http.ResponseWriter, *http.Request, error)) http.HandlerFunc
+}) error {
+	var caught error
+	chain.Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		caught = err
+	}).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	return caught
+}
+
+func TestAdaptPassesChangesToRestOfChain(t *testing.T) {
+	handler := Chain2(Adapt(tagging), func(response http.ResponseWriter, request *http.Request, _ struct{}) error {
+		return tagged(response, request)
+	})
+	if err := serveAdapted(handler); nil != err {
+		t.Error(err)
+	}
+}
+
+func TestAdaptPassesChangesWhenLifted(t *testing.T) {
+	handler := Chain3(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, nil
+	}, Lift2[int](Adapt(tagging)), func(response http.ResponseWriter, request *http.Request, _ int, _ struct{}) error {
+		return tagged(response, request)
+	})
+	if err := serveAdapted(handler); nil != err {
+		t.Error(err)
+	}
+}
+
+func TestAdaptPassesChangesPastStepDeadline(t *testing.T) {
+	handler := Chain2(Adapt(tagging), func(response http.ResponseWriter, request *http.Request, _ struct{}) error {
+		if _, ok := request.Context().Deadline(); ok {
+			t.Error("expected step deadline not to apply to the rest of the chain")
+		}
+		return tagged(response, request)
+	}).With(StepTimeout(1, time.Hour))
+	if err := serveAdapted(handler); nil != err {
+		t.Error(err)
+	}
+}
+
+func TestAdaptAbortsIfNextIsNotCalled(t *testing.T) {
+	handler := Chain2(Adapt(func(http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, _ *http.Request) {
+			response.WriteHeader(http.StatusUnauthorized)
+		})
+	}), func(http.ResponseWriter, *http.Request, struct{}) error {
+		t.Error("expected rest of the chain not to be called")
+		return nil
+	})
+	if err := serveAdapted(handler); nil != err {
+		t.Errorf("expected catch not to be called, got %v", err)
+	}
+}
+
+func TestAdaptRejectsNestedChanges(t *testing.T) {
+	rest := func(response http.ResponseWriter, request *http.Request, _ struct{}) error {
+		if nil == tagged(response, request) {
+			t.Error("expected changes not to be passed to the rest of the chain")
+		}
+		return nil
+	}
+	tests := []struct {
+		name string
+		fn   func(http.ResponseWriter, *http.Request) (struct{}, error)
+	}{
+		{"sub", Sub2(Adapt(tagging), func(http.ResponseWriter, *http.Request, struct{}) (struct{}, error) {
+			return struct{}{}, nil
+		})},
+		{"switch", Switch(Default(Adapt(tagging)))},
+		{"retry", Retry1(Adapt(tagging), RetryPolicy{MaxAttempts: 2, BaseDelay: time.Nanosecond})},
+		{"parallel", func(response http.ResponseWriter, request *http.Request) (struct{}, error) {
+			_, err := Parallel2(Adapt(tagging), Adapt(tagging))(response, request)
+			return struct{}{}, err
+		}},
+		{"direct call", func(response http.ResponseWriter, request *http.Request) (struct{}, error) {
+			return Adapt(tagging)(response, request)
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := serveAdapted(Chain2(test.fn, rest)); !errors.Is(err, ErrNotAdaptable) {
+				t.Errorf("expected error to be %v, got %v", ErrNotAdaptable, err)
+			}
+		})
+	}
+}
+
+func TestAdaptAllowsNestedMiddlewaresWithoutChanges(t *testing.T) {
+	passing := Adapt(func(next http.Handler) http.Handler { return next })
+	handler := Chain2(Sub2(passing, func(http.ResponseWriter, *http.Request, struct{}) (struct{}, error) {
+		return struct{}{}, nil
+	}), func(http.ResponseWriter, *http.Request, struct{}) error {
+		return nil
+	})
+	if err := serveAdapted(handler); nil != err {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestAdaptOutsideChain(t *testing.T) {
+	if _, err := Adapt(tagging)(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil)); !errors.Is(err, ErrNotAdaptable) {
+		t.Errorf("expected error to be %v, got %v", ErrNotAdaptable, err)
+	}
+}
+
+func TestAdaptPositionsSurviveOptions(t *testing.T) {
+	prefix := Prefix1(Adapt(tagging)).With(Buffer(0))
+	handler := prefix.Then(func(response http.ResponseWriter, request *http.Request, _ struct{}) error {
+		return tagged(response, request)
+	}).With(Observe(ObserverFunc(func(Report) {})))
+	if err := serveAdapted(handler); nil != err {
+		t.Error(err)
+	}
+	if nil != prefix.config.adapts {
+		t.Error("expected prefix config not to be modified")
+	}
+}
@@ -0,0 +1,75 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrNoBranch is returned by functions created via [Switch], and [SwitchOn] if none of their cases match the request.
+var ErrNoBranch = errors.New("no branch matched the request")
+
+// Case is a branch of a function created via [Switch]. It is created via [On], or [Default].
+type Case[O any] struct {
+	match func(*http.Request) bool
+	fn    func(http.ResponseWriter, *http.Request) (O, error)
+}
+
+// On creates a [Case] that executes fn if match reports true for the request.
+func On[O any](match func(*http.Request) bool, fn func(http.ResponseWriter, *http.Request) (O, error)) Case[O] {
+	return Case[O]{match: match, fn: fn}
+}
+
+// Default creates a [Case] that matches any request. It is usually the last case passed to [Switch].
+func Default[O any](fn func(http.ResponseWriter, *http.Request) (O, error)) Case[O] {
+	return Case[O]{fn: fn}
+}
+
+// Switch creates a function that executes function of the first case that matches the request, and returns its results. Functions of the cases are usually sub-chains created via [Sub2], and the like, that yield the same type. It returns [ErrNoBranch] if none of the cases match the request.
+func Switch[O any](cases ...Case[O]) func(http.ResponseWriter, *http.Request) (O, error) {
+	return func(response http.ResponseWriter, request *http.Request) (o O, err error) {
+		for _, c := range cases {
+			if nil == c.match || c.match(request) {
+				return c.fn(response, request)
+			}
+		}
+		return o, ErrNoBranch
+	}
+}
+
+// When creates a function that executes then if match reports true for the request, and otherwise if it does not.
+func When[O any](match func(*http.Request) bool, then, otherwise func(http.ResponseWriter, *http.Request) (O, error)) func(http.ResponseWriter, *http.Request) (O, error) {
+	return Switch(On(match, then), Default(otherwise))
+}
+
+// CaseOn is a branch of a function created via [SwitchOn]. It is created via [OnResult], or [DefaultOn].
+type CaseOn[I, O any] struct {
+	match func(*http.Request, I) bool
+	fn    func(http.ResponseWriter, *http.Request, I) (O, error)
+}
+
+// OnResult creates a [CaseOn] that executes fn if match reports true for the request, and result of the previous function call.
+func OnResult[I, O any](match func(*http.Request, I) bool, fn func(http.ResponseWriter, *http.Request, I) (O, error)) CaseOn[I, O] {
+	return CaseOn[I, O]{match: match, fn: fn}
+}
+
+// DefaultOn creates a [CaseOn] that matches any request, and previous result. It is usually the last case passed to [SwitchOn].
+func DefaultOn[I, O any](fn func(http.ResponseWriter, *http.Request, I) (O, error)) CaseOn[I, O] {
+	return CaseOn[I, O]{fn: fn}
+}
+
+// SwitchOn is like [Switch], but cases are matched against result of the previous function call as well, which is also passed to their functions. It can be used at any position of a chain via [LiftLast2], and the like.
+func SwitchOn[I, O any](cases ...CaseOn[I, O]) func(http.ResponseWriter, *http.Request, I) (O, error) {
+	return func(response http.ResponseWriter, request *http.Request, i I) (o O, err error) {
+		for _, c := range cases {
+			if nil == c.match || c.match(request, i) {
+				return c.fn(response, request, i)
+			}
+		}
+		return o, ErrNoBranch
+	}
+}
+
+// WhenOn is like [When], but match is called with result of the previous function call as well, which is also passed to then, and otherwise.
+func WhenOn[I, O any](match func(*http.Request, I) bool, then, otherwise func(http.ResponseWriter, *http.Request, I) (O, error)) func(http.ResponseWriter, *http.Request, I) (O, error) {
+	return SwitchOn(OnResult(match, then), DefaultOn(otherwise))
+}
@@ -0,0 +1,122 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func isMethod(m string) func(*http.Request) bool {
+	return func(request *http.Request) bool {
+		return request.Method == m
+	}
+}
+
+func returning(s string) func(http.ResponseWriter, *http.Request) (string, error) {
+	return func(http.ResponseWriter, *http.Request) (string, error) {
+		return s, nil
+	}
+}
+
+func TestSwitchFirstMatchWins(t *testing.T) {
+	fn := Switch(
+		On(isMethod(http.MethodGet), returning("get")),
+		On(isMethod(http.MethodGet), returning("second get")),
+		Default(returning("default")),
+	)
+	tests := []struct {
+		method string
+		want   string
+	}{
+		{http.MethodGet, "get"},
+		{http.MethodPost, "default"},
+	}
+	for _, test := range tests {
+		got, err := fn(httptest.NewRecorder(), httptest.NewRequest(test.method, "/", nil))
+		if nil != err || got != test.want {
+			t.Errorf("expected %q for %s, got %q, and %v", test.want, test.method, got, err)
+		}
+	}
+}
+
+func TestSwitchReturnsErrNoBranch(t *testing.T) {
+	fn := Switch(On(isMethod(http.MethodGet), returning("get")))
+	if _, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil)); !errors.Is(err, ErrNoBranch) {
+		t.Errorf("expected error to be %v, got %v", ErrNoBranch, err)
+	}
+}
+
+func TestSwitchReturnsCaseError(t *testing.T) {
+	errFailed := errors.New("failed")
+	fn := Switch(Default(func(http.ResponseWriter, *http.Request) (string, error) {
+		return "", errFailed
+	}))
+	if _, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil)); err != errFailed {
+		t.Errorf("expected error to be %v, got %v", errFailed, err)
+	}
+}
+
+func TestWhen(t *testing.T) {
+	fn := When(isMethod(http.MethodGet), returning("then"), returning("otherwise"))
+	if got, _ := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil)); got != "then" {
+		t.Errorf("expected %q, got %q", "then", got)
+	}
+	if got, _ := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil)); got != "otherwise" {
+		t.Errorf("expected %q, got %q", "otherwise", got)
+	}
+}
+
+func TestSwitchOnMatchesPreviousResult(t *testing.T) {
+	positive := func(_ *http.Request, i int) bool { return i > 0 }
+	label := func(s string) func(http.ResponseWriter, *http.Request, int) (string, error) {
+		return func(_ http.ResponseWriter, _ *http.Request, i int) (string, error) {
+			if i == 0 {
+				return "", errors.New("expected previous result to be passed")
+			}
+			return s, nil
+		}
+	}
+	fn := SwitchOn(OnResult(positive, label("positive")), OnResult(positive, label("second positive")), DefaultOn(label("other")))
+	tests := []struct {
+		in   int
+		want string
+	}{
+		{1, "positive"},
+		{-1, "other"},
+	}
+	for _, test := range tests {
+		got, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), test.in)
+		if nil != err || got != test.want {
+			t.Errorf("expected %q for %d, got %q, and %v", test.want, test.in, got, err)
+		}
+	}
+	fn = SwitchOn(OnResult(positive, label("positive")))
+	if _, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), -1); !errors.Is(err, ErrNoBranch) {
+		t.Errorf("expected error to be %v, got %v", ErrNoBranch, err)
+	}
+	when := WhenOn(positive, label("then"), label("otherwise"))
+	if got, _ := when(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), -1); got != "otherwise" {
+		t.Errorf("expected %q, got %q", "otherwise", got)
+	}
+}
+
+func TestSwitchInChain(t *testing.T) {
+	var caught error
+	handler := Chain2(Switch(On(isMethod(http.MethodGet), returning("get"))), func(_ http.ResponseWriter, _ *http.Request, s string) error {
+		if s != "get" {
+			t.Errorf("expected %q, got %q", "get", s)
+		}
+		return nil
+	}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		caught = err
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if nil != caught {
+		t.Errorf("expected no error, got %v", caught)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	if !errors.Is(caught, ErrNoBranch) {
+		t.Errorf("expected error to be %v, got %v", ErrNoBranch, caught)
+	}
+}
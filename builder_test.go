@@ -0,0 +1,181 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func benchmark(b *testing.B, handler http.Handler) {
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		handler.ServeHTTP(response, request)
+	}
+}
+
+func benchmarkM1(http.ResponseWriter, *http.Request) (string, error) {
+	return "First!", nil
+}
+
+func benchmarkM2(http.ResponseWriter, *http.Request, string) (int, error) {
+	return -42, nil
+}
+
+func benchmarkM3(http.ResponseWriter, *http.Request, string, int) (bool, error) {
+	return true, nil
+}
+
+func benchmarkHandler(http.ResponseWriter, *http.Request, string, int, bool) error {
+	return nil
+}
+
+func benchmarkP3(http.ResponseWriter, *http.Request, int) (bool, error) {
+	return true, nil
+}
+
+func benchmarkPipeHandler(http.ResponseWriter, *http.Request, bool) error {
+	return nil
+}
+
+// BenchmarkDirect calls the same functions as BenchmarkChain4 directly, the way chains did before they got options, so the overhead of the chain runtime can be compared against it.
+func BenchmarkDirect(b *testing.B) {
+	benchmark(b, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a1, err := benchmarkM1(w, r)
+		if nil != err {
+			return
+		}
+		a2, err := benchmarkM2(w, r, a1)
+		if nil != err {
+			return
+		}
+		a3, err := benchmarkM3(w, r, a1, a2)
+		if nil != err {
+			return
+		}
+		_ = benchmarkHandler(w, r, a1, a2, a3)
+	}))
+}
+
+func BenchmarkChain4(b *testing.B) {
+	benchmark(b, Chain4(benchmarkM1, benchmarkM2, benchmarkM3, benchmarkHandler))
+}
+
+func BenchmarkChain4Observed(b *testing.B) {
+	observer := ObserverFunc(func(Report) {})
+	benchmark(b, Chain4(benchmarkM1, benchmarkM2, benchmarkM3, benchmarkHandler).With(Observe(observer)))
+}
+
+func BenchmarkPipe4(b *testing.B) {
+	benchmark(b, Pipe4(benchmarkM1, benchmarkM2, benchmarkP3, benchmarkPipeHandler))
+}
+
+func BenchmarkBuilder(b *testing.B) {
+	benchmark(b, Then(Then(Start(benchmarkM1), benchmarkM2), benchmarkP3).Handle(benchmarkPipeHandler))
+}
+
+func TestBuilderPassesPreviousResult(t *testing.T) {
+	var got []any
+	handler := Then(Then(Start(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 1, nil
+	}), func(_ http.ResponseWriter, _ *http.Request, a int) (string, error) {
+		got = append(got, a)
+		return "two", nil
+	}), func(_ http.ResponseWriter, _ *http.Request, b string) (bool, error) {
+		got = append(got, b)
+		return true, nil
+	}).Handle(func(_ http.ResponseWriter, _ *http.Request, c bool) error {
+		got = append(got, c)
+		return nil
+	}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		t.Errorf("expected catch not to be called, got %v", err)
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if len(got) != 3 || got[0] != 1 || got[1] != "two" || got[2] != true {
+		t.Errorf("expected results %v, got %v", []any{1, "two", true}, got)
+	}
+}
+
+func TestBuilderStopsOnError(t *testing.T) {
+	errFailed := errors.New("failed")
+	for _, tc := range []struct {
+		err    error
+		caught bool
+	}{
+		{err: errFailed, caught: true},
+		{err: ErrAbort, caught: false},
+	} {
+		caught := false
+		handler := Then(Start(func(http.ResponseWriter, *http.Request) (int, error) {
+			return 1, nil
+		}), func(http.ResponseWriter, *http.Request, int) (int, error) {
+			return 0, tc.err
+		}).Handle(func(http.ResponseWriter, *http.Request, int) error {
+			t.Error("expected handler not to be called")
+			return nil
+		}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+			caught = true
+			if !errors.Is(err, tc.err) {
+				t.Errorf("expected error to be %v, got %v", tc.err, err)
+			}
+		})
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		if caught != tc.caught {
+			t.Errorf("expected catch to be called %t on %v, got %t", tc.caught, tc.err, caught)
+		}
+	}
+}
+
+func TestBuilderBranchesDoNotShareFunctions(t *testing.T) {
+	start := Start(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 1, nil
+	})
+	first := Then(start, func(http.ResponseWriter, *http.Request, int) (int, error) {
+		return 2, nil
+	}).Handle(func(http.ResponseWriter, *http.Request, int) error {
+		return nil
+	})
+	second := Then(start, func(http.ResponseWriter, *http.Request, int) (string, error) {
+		return "two", nil
+	}).Handle(func(http.ResponseWriter, *http.Request, string) error {
+		return nil
+	})
+	if len(first.steps()) != 3 || len(second.steps()) != 3 {
+		t.Errorf("expected 3 steps for each chain, got %d, and %d", len(first.steps()), len(second.steps()))
+	}
+	if first.steps()[1].Type.Out(0) == second.steps()[1].Type.Out(0) {
+		t.Error("expected chains built from the same builder not to share functions")
+	}
+}
+
+func TestBuilderStoresResults(t *testing.T) {
+	key := NewKey[string]("user")
+	handler := Then(Start(func(http.ResponseWriter, *http.Request) (string, error) {
+		return "gopher", nil
+	}), func(_ http.ResponseWriter, request *http.Request, _ string) (int, error) {
+		if user, ok := key.From(request.Context()); !ok || user != "gopher" {
+			t.Errorf("expected %q to be stored under the key, got %q", "gopher", user)
+		}
+		return 0, nil
+	}).Handle(func(http.ResponseWriter, *http.Request, int) error {
+		return nil
+	}).With(Store(1, key))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
+func TestBuilderStorePanicsOnTypeMismatch(t *testing.T) {
+	chain := Start(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, nil
+	}).Handle(func(http.ResponseWriter, *http.Request, int) error {
+		return nil
+	})
+	defer func() {
+		if nil == recover() {
+			t.Error("expected a panic")
+		}
+	}()
+	chain.With(Store(1, NewKey[string]("user")))
+}
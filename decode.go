@@ -0,0 +1,96 @@
+package middle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxBodyBytes is the maximum request body size functions created via [DecodeJSON] accept, if [JSONOptions.MaxBytes] is zero.
+const DefaultMaxBodyBytes = 1 << 20
+
+// JSONOptions configures functions created via [DecodeJSON].
+type JSONOptions struct {
+	// MaxBytes is the maximum request body size in bytes. Defaults to [DefaultMaxBodyBytes] if it is zero. A negative value means no limit.
+	MaxBytes int64
+	// DisallowUnknownFields makes decoding fail if the request body has an object key that does not match any exported field of the destination struct, the same way [encoding/json.Decoder.DisallowUnknownFields] does.
+	DisallowUnknownFields bool
+}
+
+// DecodeJSON creates a function that decodes the JSON request body into a value of type T. It fails with an [*HTTPError] of:
+//   - [net/http.StatusUnsupportedMediaType] status if the request Content-Type is not "application/json", or a "+json" suffixed media type,
+//   - [net/http.StatusRequestEntityTooLarge] status if the request body is larger than the maximum size,
+//   - [net/http.StatusBadRequest] status if the request body is empty, is not valid JSON, has a value that does not match type of its destination, has an unknown field if unknown fields are disallowed, or has anything but whitespace after the JSON value.
+//
+// Messages of the errors describe the problem, and are safe to be sent to the client.
+func DecodeJSON[T any](options JSONOptions) func(http.ResponseWriter, *http.Request) (T, error) {
+	maxBytes := options.MaxBytes
+	if maxBytes == 0 {
+		maxBytes = DefaultMaxBodyBytes
+	}
+	return func(response http.ResponseWriter, request *http.Request) (t T, err error) {
+		if !isJSON(request.Header.Get("Content-Type")) {
+			return t, &HTTPError{Status: http.StatusUnsupportedMediaType, Message: "Content-Type must be application/json"}
+		}
+		body := request.Body
+		if maxBytes > 0 {
+			body = http.MaxBytesReader(response, body, maxBytes)
+		}
+		decoder := json.NewDecoder(body)
+		if options.DisallowUnknownFields {
+			decoder.DisallowUnknownFields()
+		}
+		if err := decoder.Decode(&t); nil != err {
+			return t, jsonError(err)
+		}
+		if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return t, jsonError(err)
+			}
+			return t, &HTTPError{Status: http.StatusBadRequest, Message: "request body must contain a single JSON value", Err: err}
+		}
+		return t, nil
+	}
+}
+
+// isJSON reports whether contentType is "application/json", or a "+json" suffixed media type, e.g., "application/merge-patch+json".
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if nil != err {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// jsonError converts err returned by [encoding/json.Decoder.Decode] to an [*HTTPError] describing it.
+func jsonError(err error) error {
+	var (
+		syntaxErr   *json.SyntaxError
+		typeErr     *json.UnmarshalTypeError
+		maxBytesErr *http.MaxBytesError
+	)
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return &HTTPError{Status: http.StatusRequestEntityTooLarge, Message: fmt.Sprintf("request body must not be larger than %d bytes", maxBytesErr.Limit), Err: err}
+	case errors.Is(err, io.EOF):
+		return &HTTPError{Status: http.StatusBadRequest, Message: "request body must not be empty", Err: err}
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return &HTTPError{Status: http.StatusBadRequest, Message: "request body contains malformed JSON", Err: err}
+	case errors.As(err, &syntaxErr):
+		return &HTTPError{Status: http.StatusBadRequest, Message: fmt.Sprintf("request body contains malformed JSON at offset %d", syntaxErr.Offset), Err: err}
+	case errors.As(err, &typeErr):
+		if typeErr.Field != "" {
+			return &HTTPError{Status: http.StatusBadRequest, Message: fmt.Sprintf("request body contains an invalid value for field %q", typeErr.Field), Err: err}
+		}
+		return &HTTPError{Status: http.StatusBadRequest, Message: fmt.Sprintf("request body contains an invalid value at offset %d", typeErr.Offset), Err: err}
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		return &HTTPError{Status: http.StatusBadRequest, Message: "request body contains unknown field " + strings.TrimPrefix(err.Error(), "json: unknown field "), Err: err}
+	default:
+		return &HTTPError{Status: http.StatusBadRequest, Message: "request body can not be decoded", Err: err}
+	}
+}
@@ -0,0 +1,67 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type decoded struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func TestDecodeJSONStatuses(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		options     JSONOptions
+		status      int
+	}{
+		{"wrong content type", "text/plain", `{"name":"a"}`, JSONOptions{}, http.StatusUnsupportedMediaType},
+		{"missing content type", "", `{"name":"a"}`, JSONOptions{}, http.StatusUnsupportedMediaType},
+		{"too large value", "application/json", `{"name":"abcdefghijklmnop"}`, JSONOptions{MaxBytes: 8}, http.StatusRequestEntityTooLarge},
+		{"too large trailing data", "application/json", `{"age":1}` + strings.Repeat(" ", 16), JSONOptions{MaxBytes: 10}, http.StatusRequestEntityTooLarge},
+		{"empty body", "application/json", "", JSONOptions{}, http.StatusBadRequest},
+		{"syntax error", "application/json", `{"name":}`, JSONOptions{}, http.StatusBadRequest},
+		{"truncated value", "application/json", `{"name":"a"`, JSONOptions{}, http.StatusBadRequest},
+		{"type error", "application/json", `{"age":"old"}`, JSONOptions{}, http.StatusBadRequest},
+		{"unknown field", "application/json", `{"nickname":"a"}`, JSONOptions{DisallowUnknownFields: true}, http.StatusBadRequest},
+		{"trailing value", "application/json", `{"name":"a"} {}`, JSONOptions{}, http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body))
+			if test.contentType != "" {
+				request.Header.Set("Content-Type", test.contentType)
+			}
+			_, err := DecodeJSON[decoded](test.options)(httptest.NewRecorder(), request)
+			var httpErr *HTTPError
+			if !errors.As(err, &httpErr) {
+				t.Fatalf("expected an *HTTPError, got %v", err)
+			}
+			if httpErr.Status != test.status {
+				t.Errorf("expected status %d, got %d: %s", test.status, httpErr.Status, httpErr.Message)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONDecodesBody(t *testing.T) {
+	for _, contentType := range []string{"application/json", "application/json; charset=utf-8", "application/merge-patch+json"} {
+		t.Run(contentType, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"a","age":1,"nickname":"b"}`+"\n"))
+			request.Header.Set("Content-Type", contentType)
+			value, err := DecodeJSON[decoded](JSONOptions{})(httptest.NewRecorder(), request)
+			if nil != err {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if value != (decoded{Name: "a", Age: 1}) {
+				t.Errorf("expected %+v, got %+v", decoded{Name: "a", Age: 1}, value)
+			}
+		})
+	}
+}
@@ -7,12 +7,14 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/xeptore/middle/v6"
+	middlerouter "github.com/xeptore/middle/v6/httprouter"
 )
 
 func main() {
 	router := httprouter.New()
 	router.Handler("GET", "/path-that-ignores-handler-error", middle.Chain4(m1, m2, m3, handler))
 	router.Handler("GET", "/path-that-handles-handler-error", middle.Chain4(m1, m2, m3, handler).Finally(unexpectedErrHandle))
+	router.GET("/users/:name", middlerouter.Chain2(user, greet).Finally(unexpectedParamsErrHandle))
 	http.ListenAndServe("127.0.0.1:1080", router)
 }
 
@@ -41,3 +43,16 @@ func unexpectedErrHandle(w http.ResponseWriter, r *http.Request, err error) {
 	w.WriteHeader(http.StatusInternalServerError)
 	w.Write([]byte("internal error!"))
 }
+
+func user(w http.ResponseWriter, r *http.Request, params httprouter.Params) (string, error) {
+	return params.ByName("name"), nil
+}
+
+func greet(w http.ResponseWriter, r *http.Request, params httprouter.Params, name string) error {
+	w.Write([]byte(fmt.Sprintf("Hello, %s!", name)))
+	return nil
+}
+
+func unexpectedParamsErrHandle(w http.ResponseWriter, r *http.Request, params httprouter.Params, err error) {
+	unexpectedErrHandle(w, r, err)
+}
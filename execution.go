@@ -0,0 +1,225 @@
+package middle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrClientGone is the error a chain stops with if the client goes away, i.e., the request context is canceled, before the chain finishes. Functions in the chain that fail with an error while the client is gone are reported with an error wrapping both ErrClientGone, and the function error. Unless the chain is configured with [CatchClientGone], it is not passed to catch callbacks. It can be disabled via [IgnoreClientGone] option.
+var ErrClientGone = errors.New("client gone")
+
+// execution holds state of a single chain execution, i.e., a single request served by a chain.
+type execution struct {
+	config   *config
+	recorder ResponseRecorder
+	// base is the request the chain is executed for, with the chain deadline set on its context, if there is any.
+	base *http.Request
+	// writer is the response writer passed to functions in the chain, unless they have a deadline. It is the recorder, unless it is replaced via [Adapt].
+	writer http.ResponseWriter
+	// response, and request are passed to the current function in the chain. They are different from writer, and base if the function has a deadline.
+	response http.ResponseWriter
+	request  *http.Request
+	cancel   context.CancelFunc
+	// cancelStep cancels the current function deadline context, if it has one.
+	cancelStep context.CancelFunc
+	step       int
+	err        error
+	start      time.Time
+	// plain reports whether the chain has no deadline, so functions can be executed without any per-function setup.
+	plain bool
+}
+
+// executions pools chain executions, so serving a request does not allocate one.
+var executions = sync.Pool{New: func() any { return new(execution) }}
+
+// execute starts a new execution of a chain configured with c. The execution is returned to the pool once it finishes, so it must not be used afterward.
+func (c *config) execute(response http.ResponseWriter, request *http.Request) *execution {
+	exec := executions.Get().(*execution)
+	exec.config, exec.base, exec.plain = c, request, !c.deadlines()
+	if c.observed() {
+		// The start time is only needed to report the execution duration.
+		exec.start = time.Now()
+	}
+	exec.recorder.writer, exec.recorder.exec = response, exec
+	exec.writer = &exec.recorder
+	if nil != c && c.buffer {
+		exec.recorder.bufferUpTo(c.bufferLimit)
+	}
+	if nil != c && c.timeout > 0 {
+		ctx, cancel := context.WithTimeout(request.Context(), c.timeout)
+		exec.base, exec.cancel = request.WithContext(ctx), cancel
+	}
+	return exec
+}
+
+// next moves the execution to the next function in the chain, and reports whether it can be executed. It is kept small enough to be inlined, so chains without deadlines only pay for the client-gone check.
+func (exec *execution) next() bool {
+	exec.step++
+	exec.response, exec.request = exec.writer, exec.base
+	if exec.plain && nil == exec.base.Context().Err() {
+		return true
+	}
+	return exec.prepare()
+}
+
+// prepare sets up execution of the current function in the chain, i.e., checks whether the client went away, and sets its deadline, if it has any, and reports whether it can be executed. If it can not, the execution is moved back to the previous function, as the current one never runs.
+func (exec *execution) prepare() bool {
+	if exec.clientGone() {
+		exec.err = ErrClientGone
+		exec.step--
+		return false
+	}
+	if !exec.config.deadlines() {
+		return true
+	}
+	ctx := exec.base.Context()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		exec.err = &TimeoutError{Step: exec.step}
+		exec.step--
+		return false
+	}
+	if d := exec.config.stepTimeouts[exec.step]; d > 0 {
+		ctx, exec.cancelStep = context.WithTimeout(ctx, d)
+		exec.request = exec.base.WithContext(ctx)
+	}
+	if _, ok := ctx.Deadline(); ok {
+		exec.response = &deadlineWriter{exec.writer, ctx}
+	}
+	return true
+}
+
+// done records err returned by the current function in the chain, and reports whether the execution can continue with the next one. Same as next, it is kept small enough to be inlined.
+func (exec *execution) done(err error) bool {
+	if exec.plain && nil == err {
+		return true
+	}
+	return exec.fail(err)
+}
+
+// fail records err returned by the current function in the chain, if it is non-nil, or the function deadline passed, and cancels the function deadline context, if it has one. It reports whether the execution can continue with the next one.
+func (exec *execution) fail(err error) bool {
+	if exec.config.deadlines() && errors.Is(exec.request.Context().Err(), context.DeadlineExceeded) {
+		err = &TimeoutError{Step: exec.step}
+	} else if nil != err && exec.clientGone() {
+		err = fmt.Errorf("%w: %w", ErrClientGone, err)
+	}
+	if nil != exec.cancelStep {
+		exec.cancelStep()
+		exec.cancelStep = nil
+	}
+	if nil != err {
+		exec.err = err
+		return false
+	}
+	return true
+}
+
+// replace makes the execution pass response, and request to the rest of functions in the chain, instead of the ones passed to the current function.
+func (exec *execution) replace(response http.ResponseWriter, request *http.Request) {
+	if response != exec.response {
+		exec.writer = response
+	}
+	if request != exec.request {
+		if nil != exec.cancelStep {
+			// The request context is derived from the current function deadline context, which is canceled as soon as the function returns.
+			request = request.WithContext(valuesContext{exec.base.Context(), request.Context()})
+		}
+		exec.base = request
+	}
+}
+
+// valuesContext is a context that looks up values in values, but is otherwise the embedded context.
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c valuesContext) Value(key any) any {
+	return c.values.Value(key)
+}
+
+// finish finishes the execution by calling catch with the recorded error, if there is any, and it is not [ErrAbort], or [ErrClientGone], sending the buffered response, if any, and notifying observers. It must be called deferred, as it reports panics to the observers, and re-panics with the same value, discarding the buffered response.
+func (exec *execution) finish(catch func(http.ResponseWriter, *http.Request, error)) {
+	defer exec.release()
+	if recovered := recover(); nil != recovered {
+		exec.recorder.Reset()
+		exec.report(OutcomePanic, recovered)
+		panic(recovered)
+	}
+	outcome := OutcomeOK
+	switch {
+	case nil == exec.err:
+	case errors.Is(exec.err, ErrAbort):
+		outcome = OutcomeAbort
+	case errors.Is(exec.err, ErrClientGone):
+		outcome = OutcomeClientGone
+		if nil != exec.config && exec.config.catchClientGone {
+			exec.catch(catch)
+		}
+	default:
+		outcome = OutcomeError
+		exec.catch(catch)
+	}
+	_ = exec.recorder.commit()
+	exec.report(outcome, nil)
+}
+
+// catch calls catch, if it is non-nil, with the recorded error, discarding the buffered response first.
+func (exec *execution) catch(catch func(http.ResponseWriter, *http.Request, error)) {
+	if nil == catch {
+		return
+	}
+	exec.recorder.Reset()
+	catch(&exec.recorder, exec.base, exec.err)
+}
+
+// clientGone reports whether the client went away, i.e., the request context was canceled, unless the chain ignores it.
+func (exec *execution) clientGone() bool {
+	if nil != exec.config && exec.config.ignoreClientGone {
+		return false
+	}
+	return errors.Is(exec.base.Context().Err(), context.Canceled)
+}
+
+// release cancels the execution deadline contexts, and returns the execution to the pool.
+func (exec *execution) release() {
+	if nil != exec.cancelStep {
+		exec.cancelStep()
+	}
+	if nil != exec.cancel {
+		exec.cancel()
+	}
+	*exec = execution{}
+	executions.Put(exec)
+}
+
+// report notifies observers of the finished execution.
+func (exec *execution) report(outcome Outcome, recovered any) {
+	if !exec.config.observed() {
+		return
+	}
+	report := Report{
+		Request:  exec.base,
+		Route:    exec.config.route,
+		Status:   exec.recorder.Status(),
+		Bytes:    exec.recorder.BytesWritten(),
+		Duration: time.Since(exec.start),
+		Step:     exec.step,
+		Outcome:  outcome,
+		Err:      exec.err,
+		Panic:    recovered,
+	}
+	if report.Status == 0 {
+		report.Status = http.StatusOK
+		if OutcomePanic == outcome {
+			report.Status = http.StatusInternalServerError
+		}
+	}
+	for _, observer := range exec.config.observers {
+		observer.Observe(report)
+	}
+}
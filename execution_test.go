@@ -0,0 +1,59 @@
+package middle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientGoneStopsChain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var reports []Report
+	called := 0
+	handler := Chain2(func(http.ResponseWriter, *http.Request) (int, error) {
+		cancel()
+		return 0, nil
+	}, func(http.ResponseWriter, *http.Request, int) error {
+		called++
+		return nil
+	}).With(Observe(ObserverFunc(func(report Report) {
+		reports = append(reports, report)
+	}))).Finally(func(http.ResponseWriter, *http.Request, error) {
+		t.Error("expected catch not to be called")
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx))
+	if called != 0 {
+		t.Errorf("expected second function not to be called, got %d calls", called)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reports))
+	}
+	if report := reports[0]; report.Outcome != OutcomeClientGone || report.Step != 1 || !errors.Is(report.Err, ErrClientGone) {
+		t.Errorf("expected client gone outcome at step 1, got %v at step %d with error %v", report.Outcome, report.Step, report.Err)
+	}
+}
+
+func TestPanicIsReportedWithInternalServerErrorStatus(t *testing.T) {
+	var reports []Report
+	handler := Chain1(func(http.ResponseWriter, *http.Request) error {
+		panic("boom")
+	}).With(Observe(ObserverFunc(func(report Report) {
+		reports = append(reports, report)
+	})))
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != "boom" {
+				t.Errorf("expected chain to re-panic with %q, got %v", "boom", recovered)
+			}
+		}()
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	}()
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reports))
+	}
+	if report := reports[0]; report.Outcome != OutcomePanic || report.Status != http.StatusInternalServerError || report.Panic != "boom" {
+		t.Errorf("expected panic outcome with status %d, got %v with status %d", http.StatusInternalServerError, report.Outcome, report.Status)
+	}
+}
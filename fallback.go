@@ -0,0 +1,18 @@
+package middle
+
+import "net/http"
+
+// fallBack returns o, and err as they are if err is nil. Otherwise, it returns value provided by fallback instead, if fallback accepts err, notifying observe, if it is non-nil.
+func fallBack[O any](request *http.Request, o O, err error, fallback func(error) (O, bool), observe func(*http.Request, error)) (O, error) {
+	if nil == err {
+		return o, nil
+	}
+	v, ok := fallback(err)
+	if !ok {
+		return o, err
+	}
+	if nil != observe {
+		observe(request, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,81 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFallback(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	errFailed := errors.New("failed")
+	fallback := func(err error) (string, bool) {
+		return "cached", errors.Is(err, errUnavailable)
+	}
+	tests := []struct {
+		name     string
+		result   string
+		err      error
+		want     string
+		wantErr  error
+		observed bool
+	}{
+		{"success", "fresh", nil, "fresh", nil, false},
+		{"accepted", "", errUnavailable, "cached", nil, true},
+		{"rejected", "partial", errFailed, "partial", errFailed, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var observed error
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			fn := Fallback2(func(_ http.ResponseWriter, _ *http.Request, id int) (string, error) {
+				if id != 42 {
+					t.Errorf("expected previous result 42 to be passed, got %d", id)
+				}
+				return test.result, test.err
+			}, fallback, func(r *http.Request, err error) {
+				if r != request {
+					t.Error("expected request to be passed to observe")
+				}
+				observed = err
+			})
+			got, err := fn(httptest.NewRecorder(), request, 42)
+			if got != test.want || err != test.wantErr {
+				t.Errorf("expected %q, and %v, got %q, and %v", test.want, test.wantErr, got, err)
+			}
+			if (nil != observed) != test.observed || (test.observed && observed != test.err) {
+				t.Errorf("expected observe to be called %t with %v, got %v", test.observed, test.err, observed)
+			}
+		})
+	}
+}
+
+func TestFallbackWithoutObserve(t *testing.T) {
+	fn := Fallback1(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, errors.New("failed")
+	}, func(error) (int, bool) {
+		return 42, true
+	}, nil)
+	if got, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil)); got != 42 || nil != err {
+		t.Errorf("expected 42, and no error, got %d, and %v", got, err)
+	}
+}
+
+func TestFallbackContinuesChain(t *testing.T) {
+	var got int
+	handler := Chain2(Fallback1(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, errors.New("failed")
+	}, func(error) (int, bool) {
+		return 42, true
+	}, nil), func(_ http.ResponseWriter, _ *http.Request, a int) error {
+		got = a
+		return nil
+	}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		t.Errorf("expected catch not to be called, got %v", err)
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if got != 42 {
+		t.Errorf("expected fallback value 42 to be passed, got %d", got)
+	}
+}
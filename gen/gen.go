@@ -94,6 +94,660 @@ func fnName(n int) string {
 	return fmt.Sprintf("f%d", n)
 }
 
+func catchFuncType() Code {
+	return Func().
+		Params(
+			Qual("net/http", "ResponseWriter"),
+			Add(Op("*")).Qual("net/http", "Request"),
+			Error(),
+		)
+}
+
+// chainStepCall returns call expression of the j-th (0-based) function of a chain, passing results of all previous function calls to it.
+func chainStepCall(j int) Code {
+	return Id("chain").
+		Dot(fnName(j + 1)).
+		Call(
+			append(
+				[]Code{
+					Id("exec").Dot("response"),
+					Id("exec").Dot("request"),
+				},
+				lo.Times(j, func(k int) Code { return Id(genericTypeParamName(k)) })...,
+			)...,
+		)
+}
+
+// serveBlock returns body of serve method of a chain of i functions, which executes the functions in order using the chain execution runtime, calling the j-th (0-based) function via stepCall(j).
+func serveBlock(i int, stepCall func(j int) Code) []Code {
+	return append(
+		append(
+			[]Code{
+				Id("exec").Op(":=").Id("chain").Dot("config").Dot("execute").Call(Id("response"), Id("request")),
+				Defer().Id("exec").Dot("finish").Call(Id("catch")),
+			},
+			lo.Flatten(
+				lo.Times(i-1, func(j int) []Code {
+					return []Code{
+						If(Op("!").Id("exec").Dot("next").Call()).Block(Return()),
+						List(Id(genericTypeParamName(j)), Err()).Op(":=").Add(stepCall(j)),
+						If(Op("!").Id("exec").Dot("done").Call(Err())).Block(Return()),
+						Id("keep").Call(Id("exec"), Id(genericTypeParamName(j))),
+					}
+				}),
+			)...,
+		),
+		If(Id("exec").Dot("next").Call()).Block(
+			Id("exec").Dot("done").Call(stepCall(i-1)),
+		),
+	)
+}
+
+// stepParams returns parameter types of the j-th (0-based) function of a chain, i.e., response, request, and results of all previous functions.
+func stepParams(j int) []Code {
+	return append(
+		[]Code{
+			Qual("net/http", "ResponseWriter"),
+			Add(Op("*")).Qual("net/http", "Request"),
+		},
+		lo.Times(j, func(k int) Code { return Id(alphabets[k]) })...,
+	)
+}
+
+// valueFnParams returns parameters of a chain of i functions that all return a value, i.e., the last function returns a value as well.
+func valueFnParams(i int) []Code {
+	return lo.Times(i, func(j int) Code {
+		return Id(fnName(j + 1)).Func().Params(stepParams(j)...).Parens(List(Id(alphabets[j]), Error()))
+	})
+}
+
+// genSub generates SubN function that composes i value-returning functions into a single one that can be used in another chain.
+func genSub(f *File, i int) {
+	last := genericTypeParamName(i - 1)
+	f.Commentf("Sub%d creates a sub-chain of exactly %d functions that will be executed in order, passing results of all previous function calls to each of them, the same way [%s] does. Unlike [%s], the last function returns a value as well, which is returned by the sub-chain, so that it can be used as a function in another chain. If any of the functions returns a non-nil error, including [ErrAbort], the execution stops, and the error is returned as is.", i, i, factoryFuncName(i), factoryFuncName(i))
+	f.Func().
+		Id(fmt.Sprintf("Sub%d", i)).
+		Types(lo.Times(i, func(j int) Code { return Id(alphabets[j]).Any() })...).
+		Params(valueFnParams(i)...).
+		Func().Params(stepParams(0)...).Parens(List(Id(alphabets[i-1]), Error())).
+		Block(
+			Return(
+				Func().
+					Params(
+						Id("response").Qual("net/http", "ResponseWriter"),
+						Id("request").Add(Op("*")).Qual("net/http", "Request"),
+					).
+					Parens(List(Id(last).Id(alphabets[i-1]), Err().Error())).
+					Block(
+						append(
+							lo.Flatten(
+								lo.Times(i-1, func(j int) []Code {
+									return []Code{
+										List(Id(genericTypeParamName(j)), Err()).Op(":=").Id(fnName(j + 1)).Call(subCallArgs(j)...),
+										If(Nil().Op("!=").Err()).Block(Return(Id(last), Err())),
+									}
+								}),
+							),
+							Return(Id(fnName(i)).Call(subCallArgs(i-1)...)),
+						)...,
+					),
+			),
+		)
+	f.Line()
+}
+
+// genParallel generates TupleN type, and ParallelN function which runs i independent functions concurrently as a single function of a chain.
+func genParallel(f *File, i int) {
+	tupleName := fmt.Sprintf("Tuple%d", i)
+	typeParams := lo.Times(i, func(j int) Code { return Id(alphabets[j]).Any() })
+	typeArgs := lo.Times(i, func(j int) Code { return Id(alphabets[j]) })
+	f.Commentf("%s holds results of functions executed via [Parallel%d], in the same order the functions were passed to it.", tupleName, i)
+	f.Type().
+		Id(tupleName).
+		Types(typeParams...).
+		Struct(lo.Times(i, func(j int) Code { return Id(fmt.Sprintf("V%d", j+1)).Id(alphabets[j]) })...)
+	f.Line()
+
+	f.Commentf("Parallel%d creates a function that executes exactly %d independent functions concurrently, passing the same request, and response to all of them, and returns their results as a [%s]. It can be used as a function of a chain, e.g., via [Lift2] at positions other than the first one. Functions must not write to the response, as they run concurrently. If any of the functions returns a non-nil error, or panics, context of the request passed to the other functions is canceled, and the first error, or the panic converted to [*PanicError] is returned, after all the functions return.", i, i, tupleName)
+	f.Func().
+		Id(fmt.Sprintf("Parallel%d", i)).
+		Types(typeParams...).
+		Params(lo.Times(i, func(j int) Code {
+			return Id(fnName(j + 1)).Func().Params(stepParams(0)...).Parens(List(Id(alphabets[j]), Error()))
+		})...).
+		Func().Params(stepParams(0)...).Parens(List(Id(tupleName).Types(typeArgs...), Error())).
+		Block(
+			Return(
+				Func().
+					Params(
+						Id("response").Qual("net/http", "ResponseWriter"),
+						Id("request").Add(Op("*")).Qual("net/http", "Request"),
+					).
+					Parens(List(Id("tuple").Id(tupleName).Types(typeArgs...), Err().Error())).
+					Block(
+						Err().Op("=").Id("parallel").Call(
+							append(
+								[]Code{Id("request")},
+								lo.Times(i, func(j int) Code {
+									return Func().
+										Params(Id("request").Add(Op("*")).Qual("net/http", "Request")).
+										Parens(Err().Error()).
+										Block(
+											List(Id("tuple").Dot(fmt.Sprintf("V%d", j+1)), Err()).Op("=").Id(fnName(j+1)).Call(Id("response"), Id("request")),
+											Return(Err()),
+										)
+								})...,
+							)...,
+						),
+						Return(Id("tuple"), Err()),
+					),
+			),
+		)
+	f.Line()
+}
+
+// positionedParams returns named parameters of the function at i-th (1-based) position of a chain, i.e., response, request, and results of all previous functions.
+func positionedParams(i int) []Code {
+	return append(
+		[]Code{
+			Id("response").Qual("net/http", "ResponseWriter"),
+			Id("request").Add(Op("*")).Qual("net/http", "Request"),
+		},
+		lo.Times(i-1, func(j int) Code { return Id(genericTypeParamName(j)).Id(alphabets[j]) })...,
+	)
+}
+
+// positionedFuncType returns type of a value-returning function at i-th (1-based) position of a chain.
+func positionedFuncType(i int) Code {
+	return Func().Params(stepParams(i - 1)...).Parens(List(Id(alphabets[i-1]), Error()))
+}
+
+// genRetry generates RetryN function which retries the value-returning function at i-th (1-based) position of a chain.
+func genRetry(f *File, i int) {
+	out := genericTypeParamName(i - 1)
+	f.Commentf("Retry%d creates a function of the same type as fn, to be used at position %d of a chain, that calls fn, and retries it according to policy if it fails. Waiting between attempts stops once the request context is done.", i, i)
+	f.Func().
+		Id(fmt.Sprintf("Retry%d", i)).
+		Types(lo.Times(i, func(j int) Code { return Id(alphabets[j]).Any() })...).
+		Params(Id("fn").Add(positionedFuncType(i)), Id("policy").Id("RetryPolicy")).
+		Add(positionedFuncType(i)).
+		Block(
+			Return(
+				Qual(wrapPkgQualPath(), "Func").Call(
+					Func().
+						Params(positionedParams(i)...).
+						Parens(List(Id(out).Id(alphabets[i-1]), Err().Error())).
+						Block(
+							reportWrapped("fn", Id(out), Nil()),
+							Err().Op("=").Id("policy").Dot("Do").Call(
+								Id("request").Dot("Context").Call(),
+								Func().Params().Parens(Err().Error()).Block(
+									List(Id(out), Err()).Op("=").Id("fn").Call(subCallArgs(i-1)...),
+									Return(Err()),
+								),
+							),
+							Return(Id(out), Err()),
+						),
+				),
+			),
+		)
+	f.Line()
+}
+
+// genFallback generates FallbackN function which converts errors of the value-returning function at i-th (1-based) position of a chain to a fallback value.
+func genFallback(f *File, i int) {
+	out := genericTypeParamName(i - 1)
+	f.Commentf("Fallback%d creates a function of the same type as fn, to be used at position %d of a chain, that calls fn, and if it fails, calls fallback with the error. If fallback returns true, the value it returns is used as the function result instead, so the chain continues, and observe, if it is non-nil, is called with the request, and the error, so the degraded execution can be logged. Otherwise, the error is returned as is.", i, i)
+	f.Func().
+		Id(fmt.Sprintf("Fallback%d", i)).
+		Types(lo.Times(i, func(j int) Code { return Id(alphabets[j]).Any() })...).
+		Params(
+			Id("fn").Add(positionedFuncType(i)),
+			Id("fallback").Func().Params(Error()).Parens(List(Id(alphabets[i-1]), Bool())),
+			Id("observe").Func().Params(Add(Op("*")).Qual("net/http", "Request"), Error()),
+		).
+		Add(positionedFuncType(i)).
+		Block(
+			Return(
+				Qual(wrapPkgQualPath(), "Func").Call(
+					Func().
+						Params(positionedParams(i)...).
+						Parens(List(Id(alphabets[i-1]), Error())).
+						Block(
+							reportWrapped("fn", zero(alphabets[i-1]), Nil()),
+							List(Id(out), Err()).Op(":=").Id("fn").Call(subCallArgs(i-1)...),
+							Return(Id("fallBack").Call(Id("request"), Id(out), Err(), Id("fallback"), Id("observe"))),
+						),
+				),
+			),
+		)
+	f.Line()
+}
+
+func prefixStructName(k int) string {
+	return fmt.Sprintf("ChainPrefix%d", k)
+}
+
+// genPrefix generates ChainPrefixK type, its PrefixK factory function, and its methods, which hold k value-returning functions that are shared between chains.
+func genPrefix(f *File, k int) {
+	structName := prefixStructName(k)
+	typeParams := lo.Times(k, func(j int) Code { return Id(alphabets[j]).Any() })
+	typeArgs := lo.Times(k, func(j int) Code { return Id(alphabets[j]) })
+	f.Commentf("%s holds the first %d function%s of chains, so that they can be shared between chains created via [%s.Then]%s. It also holds options that are applied to the chains it creates.", structName, k, lo.Ternary(k > 1, "s", ""), structName, lo.Ternary(k+1 < n, fmt.Sprintf(", or Prefix%dThenM functions, e.g., [Prefix%dThen2]", k, k), ""))
+	f.Type().
+		Id(structName).
+		Types(typeParams...).
+		Struct(append(valueFnParams(k), Id("config").Op("*").Id("config"))...)
+	f.Line()
+
+	f.Commentf("Prefix%d creates a chain prefix of exactly %d function%s that will be executed in order, before functions appended to it.", k, k, lo.Ternary(k > 1, "s", ""))
+	f.Func().
+		Id(fmt.Sprintf("Prefix%d", k)).
+		Types(typeParams...).
+		Params(valueFnParams(k)...).
+		Id(structName).Types(typeArgs...).
+		Block(
+			Return(
+				Id(structName).
+					Types(typeArgs...).
+					Values(append(lo.Times(k, func(j int) Code { return Id(fnName(j + 1)) }), Nil())...),
+			),
+		)
+	f.Line()
+
+	f.Comment("With returns a copy of the prefix whose chains execute with options applied on top of the options it already has.")
+	f.Func().
+		Params(Id("prefix").Id(structName).Types(typeArgs...)).
+		Id("With").
+		Params(Id("options").Op("...").Id("Option")).
+		Id(structName).Types(typeArgs...).
+		Block(
+			Id("prefix").Dot("config").Op("=").Id("prefix").Dot("config").Dot("with").Call(Id("options")),
+			Return(Id("prefix")),
+		)
+	f.Line()
+
+	f.Commentf("Then creates a chain of the prefix functions followed by handler, with the prefix options applied.")
+	f.Func().
+		Params(Id("prefix").Id(structName).Types(typeArgs...)).
+		Id("Then").
+		Params(Id("handler").Func().Params(stepParams(k)...).Error()).
+		Id(chainStructName(k+1)).Types(typeArgs...).
+		Block(
+			Id("chain").Op(":=").
+				Id(chainStructName(k+1)).
+				Types(typeArgs...).
+				Values(
+					append(
+						lo.Times(k, func(j int) Code { return Id("prefix").Dot(fnName(j + 1)) }),
+						Id("handler"),
+						Id("prefix").Dot("config"),
+					)...,
+				),
+			Id("chain").Dot("config").Op("=").Id("chain").Dot("config").Dot("check").Call(Id("chain").Dot("steps").Call()),
+			Return(Id("chain")),
+		)
+	f.Line()
+
+	f.Commentf("Middleware converts the prefix to a net/http middleware that executes the prefix functions, and then calls the next handler with a request whose context holds their results, which can be retrieved via [Value]. If any of the functions fails, the next handler is not called, and catch is called with the error instead, the same way [%s.Finally] does.", chainStructName(k+1))
+	f.Func().
+		Params(Id("prefix").Id(structName).Types(typeArgs...)).
+		Id("Middleware").
+		Params(Id("catch").Add(catchFuncType())).
+		Func().Params(Qual("net/http", "Handler")).Qual("net/http", "Handler").
+		Block(
+			Return(
+				Func().Params(Id("next").Qual("net/http", "Handler")).Qual("net/http", "Handler").Block(
+					Return(
+						Id("prefix").Dot("Then").Call(
+							Func().
+								Params(
+									append(
+										[]Code{
+											Id("response").Qual("net/http", "ResponseWriter"),
+											Id("request").Add(Op("*")).Qual("net/http", "Request"),
+										},
+										lo.Times(k, func(j int) Code { return Id(genericTypeParamName(j)).Id(alphabets[j]) })...,
+									)...,
+								).
+								Error().
+								Block(
+									append(
+										append(
+											[]Code{Id("ctx").Op(":=").Id("request").Dot("Context").Call()},
+											lo.Times(k, func(j int) Code {
+												return Id("ctx").Op("=").Id("withValue").Call(Id("ctx"), Id(genericTypeParamName(j)))
+											})...,
+										),
+										Id("next").Dot("ServeHTTP").Call(Id("response"), Id("request").Dot("WithContext").Call(Id("ctx"))),
+										Return(Nil()),
+									)...,
+								),
+						).Dot("Finally").Call(Id("catch")),
+					),
+				),
+			),
+		)
+	f.Line()
+}
+
+// genPrefixThen generates PrefixKThenM function which creates a chain of k functions of a prefix, followed by m functions.
+func genPrefixThen(f *File, k, m int) {
+	i := k + m
+	f.Commentf("Prefix%dThen%d creates a chain of prefix functions followed by exactly %d functions that will be executed in order, the same way [%s] does, with the prefix options applied.", k, m, m, factoryFuncName(i))
+	f.Func().
+		Id(fmt.Sprintf("Prefix%dThen%d", k, m)).
+		Types(genericTypes(i)...).
+		Params(
+			append(
+				[]Code{Id("prefix").Id(prefixStructName(k)).Types(lo.Times(k, func(j int) Code { return Id(alphabets[j]) })...)},
+				fnParams(i)[k:]...,
+			)...,
+		).
+		Id(chainStructName(i)).Types(parameterGenericTypes(i)...).
+		Block(
+			Id("chain").Op(":=").
+				Id(chainStructName(i)).
+				Types(parameterGenericTypes(i)...).
+				Values(
+					append(
+						append(
+							lo.Times(k, func(j int) Code { return Id("prefix").Dot(fnName(j + 1)) }),
+							lo.Times(m, func(j int) Code { return Id(fnName(k + j + 1)) })...,
+						),
+						Id("prefix").Dot("config"),
+					)...,
+				),
+			Id("chain").Dot("config").Op("=").Id("chain").Dot("config").Dot("check").Call(Id("chain").Dot("steps").Call()),
+			Return(Id("chain")),
+		)
+	f.Line()
+}
+
+// liftedParams returns parameters of a function lifted to the i-th (1-based) position of a chain, naming only the last previous result, if last is true.
+func liftedParams(i int, last bool) []Code {
+	return append(
+		[]Code{
+			Id("response").Qual("net/http", "ResponseWriter"),
+			Id("request").Add(Op("*")).Qual("net/http", "Request"),
+		},
+		lo.Times(i-1, func(j int) Code {
+			if last && j == i-2 {
+				return Id(genericTypeParamName(j)).Id(alphabets[j])
+			}
+			return Id("_").Id(alphabets[j])
+		})...,
+	)
+}
+
+// genLift generates LiftN, LiftLastN, LiftHandlerN, and LiftLastHandlerN functions, which adapt functions that do not depend on all previous results to the i-th (1-based) position of a chain.
+func genLift(f *File, i int) {
+	prev := alphabets[i-2]
+	handlerTypeParams := lo.Times(i-1, func(j int) Code { return Id(alphabets[j]).Any() })
+	lastArgs := []Code{Id("response"), Id("request"), Id(genericTypeParamName(i - 2))}
+	if i <= len(alphabets) {
+		out := alphabets[i-1]
+		valueTypeParams := lo.Times(i, func(j int) Code { return Id(alphabets[j]).Any() })
+		f.Commentf("Lift%d adapts fn, that only depends on the request, and response, to be used as the function at position %d of a chain, ignoring results of all previous function calls. Types of the previous results must be specified explicitly, e.g., Lift%d[%s](fn).", i, i, i, strings.Join(alphabets[:i-1], ", "))
+		f.Func().
+			Id(fmt.Sprintf("Lift%d", i)).
+			Types(valueTypeParams...).
+			Params(Id("fn").Func().Params(stepParams(0)...).Parens(List(Id(out), Error()))).
+			Func().Params(stepParams(i - 1)...).Parens(List(Id(out), Error())).
+			Block(
+				Return(
+					Qual(wrapPkgQualPath(), "Func").Call(
+						Func().Params(liftedParams(i, false)...).Parens(List(Id(out), Error())).Block(
+							reportWrapped("fn", zero(out), Nil()),
+							Return(Id("fn").Call(Id("response"), Id("request"))),
+						),
+					),
+				),
+			)
+		f.Line()
+
+		f.Commentf("LiftLast%d adapts fn, that only depends on the request, response, and result of the previous function call, to be used as the function at position %d of a chain, ignoring results of all other previous function calls.", i, i)
+		f.Func().
+			Id(fmt.Sprintf("LiftLast%d", i)).
+			Types(valueTypeParams...).
+			Params(Id("fn").Func().Params(append(stepParams(0), Id(prev))...).Parens(List(Id(out), Error()))).
+			Func().Params(stepParams(i - 1)...).Parens(List(Id(out), Error())).
+			Block(
+				Return(
+					Qual(wrapPkgQualPath(), "Func").Call(
+						Func().Params(liftedParams(i, true)...).Parens(List(Id(out), Error())).Block(
+							reportWrapped("fn", zero(out), Nil()),
+							Return(Id("fn").Call(lastArgs...)),
+						),
+					),
+				),
+			)
+		f.Line()
+	}
+
+	f.Commentf("LiftHandler%d adapts handler, that only depends on the request, and response, to be used as the last function of a chain of %d functions, ignoring results of all previous function calls.", i, i)
+	f.Func().
+		Id(fmt.Sprintf("LiftHandler%d", i)).
+		Types(handlerTypeParams...).
+		Params(Id("handler").Func().Params(stepParams(0)...).Error()).
+		Func().Params(stepParams(i - 1)...).Error().
+		Block(
+			Return(
+				Qual(wrapPkgQualPath(), "Func").Call(
+					Func().Params(liftedParams(i, false)...).Error().Block(
+						reportWrapped("handler", Nil()),
+						Return(Id("handler").Call(Id("response"), Id("request"))),
+					),
+				),
+			),
+		)
+	f.Line()
+
+	f.Commentf("LiftLastHandler%d adapts handler, that only depends on the request, response, and result of the previous function call, to be used as the last function of a chain of %d functions, ignoring results of all other previous function calls.", i, i)
+	f.Func().
+		Id(fmt.Sprintf("LiftLastHandler%d", i)).
+		Types(handlerTypeParams...).
+		Params(Id("handler").Func().Params(append(stepParams(0), Id(prev))...).Error()).
+		Func().Params(stepParams(i - 1)...).Error().
+		Block(
+			Return(
+				Qual(wrapPkgQualPath(), "Func").Call(
+					Func().Params(liftedParams(i, true)...).Error().Block(
+						reportWrapped("handler", Nil()),
+						Return(Id("handler").Call(lastArgs...)),
+					),
+				),
+			),
+		)
+	f.Line()
+}
+
+// wrapPkgQualPath returns import path of the package wrappers of functions are recorded via.
+func wrapPkgQualPath() string {
+	return moduleName + "/internal/wrap"
+}
+
+// reportWrapped returns a statement that makes a wrapper of the function called fn report it, returning results right away, if it is called by wrap.Unwrap.
+func reportWrapped(fn string, results ...Code) Code {
+	return If(Qual(wrapPkgQualPath(), "Report").Call(Id("request"), Id(fn))).Block(Return(results...))
+}
+
+// zero returns the zero value of type parameter typ.
+func zero(typ string) Code {
+	return Op("*").New(Id(typ))
+}
+
+// subCallArgs returns arguments passed to the j-th (0-based) function of a sub-chain.
+func subCallArgs(j int) []Code {
+	return append(
+		[]Code{Id("response"), Id("request")},
+		lo.Times(j, func(k int) Code { return Id(genericTypeParamName(k)) })...,
+	)
+}
+
+// pipeFnParams returns parameters of a pipe of i functions, where each function receives result of the previous function call only.
+func pipeFnParams(i int) []Code {
+	return lo.Times(i, func(j int) Code {
+		params := stepParams(0)
+		if j > 0 {
+			params = append(params, Id(alphabets[j-1]))
+		}
+		if j == i-1 {
+			return Id(fnName(j + 1)).Func().Params(params...).Error()
+		}
+		return Id(fnName(j + 1)).Func().Params(params...).Parens(List(Id(alphabets[j]), Error()))
+	})
+}
+
+// pipeStepCall returns call expression of the j-th (0-based) function of a pipe, passing result of the previous function call to it.
+func pipeStepCall(j int) Code {
+	args := []Code{Id("exec").Dot("response"), Id("exec").Dot("request")}
+	if j > 0 {
+		args = append(args, Id(genericTypeParamName(j-1)))
+	}
+	return Id("chain").Dot(fnName(j + 1)).Call(args...)
+}
+
+// genPipe generates PipeHandlerN type, its PipeN factory function, and its methods, which execute i functions in order, passing result of each function call to the next function only.
+func genPipe(f *File, i int) {
+	structName := fmt.Sprintf("PipeHandler%d", i)
+	factoryName := fmt.Sprintf("Pipe%d", i)
+	f.Commentf("%s provides capability of processing pipe functions in order by satisfying [net/http.Handler], or with an optional chain error handler via [%s.Finally] by satisfying [net/http.HandlerFunc]. Unlike [%s], each function receives result of the previous function call only.", structName, structName, chainStructName(i))
+	f.Type().
+		Id(structName).
+		Types(genericTypes(i)...).
+		Struct(append(pipeFnParams(i), Id("config").Op("*").Id("config"))...)
+	f.Line()
+
+	genHandlerMethods(
+		f,
+		i,
+		structName,
+		parameterGenericTypes(i),
+		"ServeHTTP satisfies [net/http.Handler]. It executes functions in the pipe in order, passing result of the previous function call to each of them. If any of the functions in the pipe returns a non-nil error, the execution stops.",
+		fmt.Sprintf("Finally executes functions registered via [%s] in order, passing result of the previous function call to each of them. If any of the functions in the pipe returns a non-nil error, the execution stops, and executes catch with that error. If the error is [ErrAbort] according to [errors.Is] semantics, it is ignored, and catch will not be called, although the pipe execution stops. The response writer passed to catch is a [*ResponseRecorder], so it can check whether the response was already written.", factoryName),
+		serveBlock(i, pipeStepCall),
+	)
+	f.Line()
+
+	f.Commentf("%s creates a pipe of exactly %d functions that will be executed in order.", factoryName, i)
+	f.Func().
+		Id(factoryName).
+		Types(genericTypes(i)...).
+		Params(pipeFnParams(i)...).
+		Id(structName).Types(parameterGenericTypes(i)...).
+		Block(
+			Id("chain").Op(":=").
+				Id(structName).
+				Types(parameterGenericTypes(i)...).
+				Values(append(lo.Times(i, func(j int) Code { return Id(fnName(j + 1)) }), Nil())...),
+			Id("chain").Dot("config").Op("=").Id("chain").Dot("config").Dot("check").Call(Id("chain").Dot("steps").Call()),
+			Return(Id("chain")),
+		)
+	f.Line()
+}
+
+// genHandlerMethods generates ServeHTTP, Finally, With, and serve methods of a chain-like structName type, documenting ServeHTTP, and Finally methods with serveHTTPDoc, and finallyDoc respectively, and using serveBody as body of the serve method.
+func genHandlerMethods(f *File, i int, structName string, typeArgs []Code, serveHTTPDoc, finallyDoc string, serveBody []Code) {
+	f.Comment(serveHTTPDoc)
+	f.
+		Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("ServeHTTP").
+		Params(
+			Id("response").Qual("net/http", "ResponseWriter"),
+			Id("request").Add(Op("*")).Qual("net/http", "Request"),
+		).
+		Block(
+			Id("chain").Dot("serve").Call(Id("response"), Id("request"), Nil()),
+		)
+
+	f.Line()
+
+	f.Comment(finallyDoc)
+	f.
+		Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("Finally").
+		Params(Id("catch").Add(catchFuncType())).
+		Qual("net/http", "HandlerFunc").
+		Block(
+			Return(
+				Func().
+					Params(
+						Id("response").Qual("net/http", "ResponseWriter"),
+						Id("request").Add(Op("*")).Qual("net/http", "Request"),
+					).
+					Block(
+						Id("chain").Dot("serve").Call(Id("response"), Id("request"), Id("catch")),
+					),
+			),
+		)
+
+	f.Line()
+
+	f.Comment("With returns a copy of the chain that executes with options applied on top of the options it already has.")
+	f.
+		Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("With").
+		Params(Id("options").Op("...").Id("Option")).
+		Id(structName).Types(typeArgs...).
+		Block(
+			Id("chain").Dot("config").Op("=").Id("chain").Dot("config").Dot("with").Call(Id("options")).Dot("check").Call(Id("chain").Dot("steps").Call()),
+			Return(Id("chain")),
+		)
+
+	f.Line()
+
+	f.
+		Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("mount").
+		Params(Id("catch").Add(catchFuncType()), Id("options").Index().Id("Option")).
+		Qual("net/http", "Handler").
+		Block(
+			Return(Id("chain").Dot("With").Call(Id("options").Op("...")).Dot("Finally").Call(Id("catch"))),
+		)
+
+	f.Line()
+
+	f.
+		Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("steps").
+		Params().
+		Index().Id("StepInfo").
+		Block(
+			Return(Index().Id("StepInfo").Values(lo.Times(i, func(j int) Code { return Id("stepInfo").Call(Id("chain").Dot(fnName(j + 1))) })...)),
+		)
+
+	f.Line()
+
+	f.
+		Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("configuration").
+		Params().
+		Op("*").Id("config").
+		Block(
+			Return(Id("chain").Dot("config")),
+		)
+
+	f.Line()
+
+	f.
+		Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("serve").
+		Params(
+			Id("response").Qual("net/http", "ResponseWriter"),
+			Id("request").Add(Op("*")).Qual("net/http", "Request"),
+			Id("catch").Add(catchFuncType()),
+		).
+		Block(serveBody...)
+}
+
 var (
 	pkg      string
 	filename string
@@ -137,171 +791,27 @@ func main() {
 			Type().
 			Id(structName).
 			Types(genericTypes(i)...).
-			Struct(fnParams(i)...)
-
-		f.Line()
-
-		if i < 2 {
-			f.Comment("ServeHTTP satisfies [net/http.Handler]. It executes the handler function, passing request, and response to it.")
-		} else {
-			f.Commentf("ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing results of all previous function calls to it. If any of the functions in the chain returns a non-nil error, the execution stops.")
-		}
-		f.
-			Func().
-			Params(Id("chain").Id(structName).Types(parameterGenericTypes(i)...)).
-			Id("ServeHTTP").
-			Params(
-				Id("response").Qual("net/http", "ResponseWriter"),
-				Id("request").Add(Op("*")).Qual("net/http", "Request"),
-			).
-			Block(
-				append(
-					lo.Flatten(
-						lo.Times(i-1, func(j int) []Code {
-							return []Code{
-								List(
-									Id(genericTypeParamName(j)),
-									Err(),
-								).
-									Op(":=").
-									Id("chain").
-									Dot(fnName(j + 1)).
-									Call(
-										append(
-											[]Code{
-												Id("response"),
-												Id("request"),
-											},
-											lo.Times(j, func(k int) Code { return Id(genericTypeParamName(k)) })...,
-										)...,
-									),
-								If(
-									Nil().
-										Op("!=").
-										Err(),
-								).
-									Block(
-										Return(),
-									),
-							}
-						}),
-					),
-					Id("_").
-						Op("=").
-						Id("chain").
-						Dot(fnName(i)).
-						Call(
-							append(
-								[]Code{
-									Id("response"),
-									Id("request"),
-								},
-								lo.Times(i-1, func(j int) Code { return Id(genericTypeParamName(j)) })...,
-							)...,
-						),
-				)...,
-			)
+			Struct(append(fnParams(i), Id("config").Op("*").Id("config"))...)
 
 		f.Line()
 
-		if i < 2 {
-			f.Commentf("Finally executes middleware function registered via [%s], passing request, and response to it.", factoryFuncName(i))
-		} else {
-			f.Commentf("Finally executes middleware functions registered via [%s] in order, passing results of all previous function calls to it. If any of the functions in the chain returns a non-nil error, the execution stops, and executes catch with that error. If the error is [ErrAbort] according to [errors.Is] semantics, it is ignored, and catch will not be called, although the chain execution stops.", factoryFuncName(i))
-		}
-		f.
-			Func().
-			Params(Id("chain").Id(structName).Types(parameterGenericTypes(i)...)).
-			Id("Finally").
-			Params(
-				Id("catch").
-					Func().
-					Params(
-						Qual("net/http", "ResponseWriter"),
-						Add(Op("*")).Qual("net/http", "Request"),
-						Error(),
-					),
-			).
-			Qual("net/http", "HandlerFunc").
-			Block(
-				Return(
-					Func().
-						Params(
-							Id("response").Qual("net/http", "ResponseWriter"),
-							Id("request").Add(Op("*")).Qual("net/http", "Request"),
-						).
-						Block(
-							append(
-								lo.Flatten(
-									lo.Times(i-1, func(j int) []Code {
-										return []Code{
-											List(
-												Id(genericTypeParamName(j)),
-												Err(),
-											).
-												Op(":=").
-												Id("chain").
-												Dot(fnName(j + 1)).
-												Call(
-													append(
-														[]Code{
-															Id("response"),
-															Id("request"),
-														},
-														lo.Times(j, func(k int) Code { return Id(genericTypeParamName(k)) })...,
-													)...,
-												),
-											If(
-												Nil().
-													Op("!=").
-													Err(),
-											).
-												Block(
-													If(
-														Op("!").
-															Add().
-															Qual("errors", "Is").
-															Call(Err(), Id("ErrAbort")),
-													).
-														Block(
-															Id("catch").Call(Id("response"), Id("request"), Err()),
-														),
-													Return(),
-												),
-										}
-									}),
-								),
-								If(
-									Err().
-										Op(":=").
-										Id("chain").
-										Dot(fnName(i)).
-										Call(
-											append(
-												[]Code{
-													Id("response"),
-													Id("request"),
-												},
-												lo.Times(i-1, func(j int) Code { return Id(genericTypeParamName(j)) })...,
-											)...,
-										),
-									Nil().Op("!=").Err(),
-								).
-									Block(
-										If(
-											Op("!").
-												Add().
-												Qual("errors", "Is").
-												Call(Err(), Id("ErrAbort")),
-										).
-											Block(
-												Id("catch").Call(Id("response"), Id("request"), Err()),
-											),
-									),
-							)...,
-						),
-				),
-			)
+		genHandlerMethods(
+			f,
+			i,
+			structName,
+			parameterGenericTypes(i),
+			lo.Ternary(
+				i < 2,
+				"ServeHTTP satisfies [net/http.Handler]. It executes the handler function, passing request, and response to it.",
+				"ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing results of all previous function calls to it. If any of the functions in the chain returns a non-nil error, the execution stops.",
+			),
+			lo.Ternary(
+				i < 2,
+				fmt.Sprintf("Finally executes middleware function registered via [%s], passing request, and response to it. If the function returns a non-nil error, that is not [ErrAbort] according to [errors.Is] semantics, catch will be called with that error, and a [*ResponseRecorder] as its response writer, so it can check whether the response was already written.", factoryFuncName(i)),
+				fmt.Sprintf("Finally executes middleware functions registered via [%s] in order, passing results of all previous function calls to it. If any of the functions in the chain returns a non-nil error, the execution stops, and executes catch with that error. If the error is [ErrAbort] according to [errors.Is] semantics, it is ignored, and catch will not be called, although the chain execution stops. The response writer passed to catch is a [*ResponseRecorder], so it can check whether the response was already written.", factoryFuncName(i)),
+			),
+			serveBlock(i, chainStepCall),
+		)
 
 		f.Line()
 
@@ -312,14 +822,43 @@ func main() {
 			Params(fnParams(i)...).
 			Id(structName).Types(parameterGenericTypes(i)...).
 			Block(
-				Return(
+				Id("chain").Op(":=").
 					Id(structName).
-						Types(parameterGenericTypes(i)...).
-						Values(lo.Times(i, func(j int) Code { return Id(fnName(j + 1)) })...),
-				),
+					Types(parameterGenericTypes(i)...).
+					Values(append(lo.Times(i, func(j int) Code { return Id(fnName(j + 1)) }), Nil())...),
+				Id("chain").Dot("config").Op("=").Id("chain").Dot("config").Dot("check").Call(Id("chain").Dot("steps").Call()),
+				Return(Id("chain")),
 			)
 	}
 
+	for i := 2; i <= n; i++ {
+		genPipe(f, i)
+	}
+	for i := 2; i <= min(n, len(alphabets)); i++ {
+		genSub(f, i)
+	}
+	for i := 2; i <= min(n, len(alphabets)); i++ {
+		genParallel(f, i)
+	}
+	for i := 1; i <= min(n, len(alphabets)); i++ {
+		genRetry(f, i)
+	}
+	for i := 1; i <= min(n, len(alphabets)); i++ {
+		genFallback(f, i)
+	}
+
+	for k := 1; k < n; k++ {
+		genPrefix(f, k)
+	}
+	for i := 2; i <= n; i++ {
+		genLift(f, i)
+	}
+	for k := 1; k < n-1; k++ {
+		for m := 2; k+m <= n; m++ {
+			genPrefixThen(f, k, m)
+		}
+	}
+
 	var buf bytes.Buffer
 	if err := f.Render(&buf); nil != err {
 		log.Fatalf("failed to generate code: %v\n", err)
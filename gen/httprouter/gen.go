@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	. "github.com/dave/jennifer/jen"
+	"github.com/samber/lo"
+)
+
+func init() {
+	flag.StringVar(&filename, "file", "./chain.go", "name of the file to write generated code in")
+	flag.IntVar(&n, "n", 27, "maximum generated number of chains")
+	flag.BoolVar(&noHeader, "no-header", false, "do not generate GENERATED header comment")
+}
+
+const (
+	middlePkgQualPath = "github.com/xeptore/middle/v6"
+	routerPkgQualPath = "github.com/julienschmidt/httprouter"
+	pkgQualPath       = "github.com/xeptore/middle/v6/httprouter"
+	wrapPkgQualPath   = "github.com/xeptore/middle/v6/internal/wrap"
+)
+
+var alphabets = []string{
+	"A", "B", "C", "D", "E", "F",
+	"G", "H", "I", "J", "K", "L", "M",
+	"N", "O", "P", "Q", "R", "S", "T",
+	"U", "V", "W", "X", "Y", "Z",
+}
+
+func chainStructName(i int) string {
+	return fmt.Sprintf("ChainHandler%d", i)
+}
+
+func factoryFuncName(i int) string {
+	return fmt.Sprintf("Chain%d", i)
+}
+
+func genericTypes(i int) []Code {
+	return lo.Times(i-1, func(j int) Code { return Id(alphabets[j]).Any() })
+}
+
+func genericTypeParamName(i int) string {
+	return strings.ToLower(alphabets[i])
+}
+
+func parameterGenericTypes(i int) []Code {
+	return lo.Times(i-1, func(j int) Code { return Id(alphabets[j]) })
+}
+
+func fnName(n int) string {
+	return fmt.Sprintf("f%d", n)
+}
+
+// stepParams returns parameter types of the j-th (0-based) function of a chain, i.e., response, request, route parameters, and results of all previous functions.
+func stepParams(j int) []Code {
+	return append(
+		[]Code{
+			Qual("net/http", "ResponseWriter"),
+			Add(Op("*")).Qual("net/http", "Request"),
+			Qual(routerPkgQualPath, "Params"),
+		},
+		lo.Times(j, func(k int) Code { return Id(alphabets[k]) })...,
+	)
+}
+
+// stepResults returns result types of the j-th (0-based) function of a chain of i functions.
+func stepResults(i, j int) Code {
+	if j == i-1 {
+		return Error()
+	}
+	return Parens(List(Id(alphabets[j]), Error()))
+}
+
+func fnParams(i int) []Code {
+	return lo.Times(i, func(j int) Code {
+		return Id(fnName(j + 1)).Func().Params(stepParams(j)...).Add(stepResults(i, j))
+	})
+}
+
+// adaptedStep returns a function that calls the j-th (0-based) function of a chain of i functions with route parameters stored in the request context, recorded as its wrapper, so the chain is described by the function.
+func adaptedStep(i, j int) Code {
+	return Qual(wrapPkgQualPath, "Func").Call(adapter(i, j))
+}
+
+// adapter returns the function literal adaptedStep registers as a wrapper of the j-th (0-based) function of a chain of i functions.
+func adapter(i, j int) Code {
+	zero := []Code{Nil()}
+	if j < i-1 {
+		zero = []Code{Op("*").New(Id(alphabets[j])), Nil()}
+	}
+	return Func().
+		Params(
+			append(
+				[]Code{
+					Id("response").Qual("net/http", "ResponseWriter"),
+					Id("request").Add(Op("*")).Qual("net/http", "Request"),
+				},
+				lo.Times(j, func(k int) Code { return Id(genericTypeParamName(k)).Id(alphabets[k]) })...,
+			)...,
+		).
+		Add(stepResults(i, j)).
+		Block(
+			If(Qual(wrapPkgQualPath, "Report").Call(Id("request"), Id(fnName(j+1)))).Block(
+				Return(zero...),
+			),
+			Return(
+				Id(fnName(j+1)).Call(
+					append(
+						[]Code{
+							Id("response"),
+							Id("request"),
+							Qual(routerPkgQualPath, "ParamsFromContext").Call(Id("request").Dot("Context").Call()),
+						},
+						lo.Times(j, func(k int) Code { return Id(genericTypeParamName(k)) })...,
+					)...,
+				),
+			),
+		)
+}
+
+func handleParams() []Code {
+	return []Code{
+		Id("response").Qual("net/http", "ResponseWriter"),
+		Id("request").Add(Op("*")).Qual("net/http", "Request"),
+		Id("params").Qual(routerPkgQualPath, "Params"),
+	}
+}
+
+func genChain(f *File, i int) {
+	structName := chainStructName(i)
+	typeArgs := parameterGenericTypes(i)
+	f.Commentf("%s is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [%s.Handle], or with an optional chain error handler via [%s.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].", structName, structName, structName)
+	f.Type().
+		Id(structName).
+		Types(genericTypes(i)...).
+		Struct(Qual(middlePkgQualPath, chainStructName(i)).Types(typeArgs...))
+	f.Line()
+
+	f.Comment("Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.")
+	f.Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("Handle").
+		Params(handleParams()...).
+		Block(
+			Id("chain").Dot(structName).Dot("ServeHTTP").Call(Id("response"), Id("withParams").Call(Id("request"), Id("params"))),
+		)
+	f.Line()
+
+	f.Comment("ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.")
+	f.Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("ServeHTTP").
+		Params(
+			Id("response").Qual("net/http", "ResponseWriter"),
+			Id("request").Add(Op("*")).Qual("net/http", "Request"),
+		).
+		Block(
+			Id("chain").Dot(structName).Dot("ServeHTTP").Call(Id("response"), Id("request")),
+		)
+	f.Line()
+
+	f.Comment("Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.")
+	f.Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("Finally").
+		Params(
+			Id("catch").Func().Params(
+				Qual("net/http", "ResponseWriter"),
+				Add(Op("*")).Qual("net/http", "Request"),
+				Qual(routerPkgQualPath, "Params"),
+				Error(),
+			),
+		).
+		Qual(routerPkgQualPath, "Handle").
+		Block(
+			Id("handler").Op(":=").Id("chain").Dot(structName).Dot("Finally").Call(
+				Func().
+					Params(
+						Id("response").Qual("net/http", "ResponseWriter"),
+						Id("request").Add(Op("*")).Qual("net/http", "Request"),
+						Err().Error(),
+					).
+					Block(
+						Id("catch").Call(
+							Id("response"),
+							Id("request"),
+							Qual(routerPkgQualPath, "ParamsFromContext").Call(Id("request").Dot("Context").Call()),
+							Err(),
+						),
+					),
+			),
+			Return(
+				Func().Params(handleParams()...).Block(
+					Id("handler").Call(Id("response"), Id("withParams").Call(Id("request"), Id("params"))),
+				),
+			),
+		)
+	f.Line()
+
+	f.Comment("With returns a copy of the chain that executes with options applied on top of the options it already has.")
+	f.Func().
+		Params(Id("chain").Id(structName).Types(typeArgs...)).
+		Id("With").
+		Params(Id("options").Op("...").Qual(middlePkgQualPath, "Option")).
+		Id(structName).Types(typeArgs...).
+		Block(
+			Id("chain").Dot(structName).Op("=").Id("chain").Dot(structName).Dot("With").Call(Id("options").Op("...")),
+			Return(Id("chain")),
+		)
+	f.Line()
+
+	f.Commentf("%s creates a chain of exactly %d function%s that will be executed in order, passing route parameters to each of them.", factoryFuncName(i), i, lo.Ternary(i > 1, "s", ""))
+	f.Func().
+		Id(factoryFuncName(i)).
+		Types(genericTypes(i)...).
+		Params(fnParams(i)...).
+		Id(structName).Types(typeArgs...).
+		Block(
+			Return(
+				Id(structName).Types(typeArgs...).Values(
+					Qual(middlePkgQualPath, factoryFuncName(i)).Call(
+						lo.Times(i, func(j int) Code { return adaptedStep(i, j) })...,
+					),
+				),
+			),
+		)
+	f.Line()
+}
+
+var (
+	filename string
+	n        int
+	noHeader bool
+)
+
+func validateFlags() error {
+	if n < 1 || n > 27 {
+		return fmt.Errorf("n cannot be < 1 or > 27")
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := validateFlags(); nil != err {
+		log.Fatalf("provided flags are invalid: %v", err)
+	}
+	f := NewFilePathName(pkgQualPath, "httprouter")
+	f.ImportAlias(routerPkgQualPath, "router")
+	f.ImportName(middlePkgQualPath, "middle")
+	if !noHeader {
+		f.HeaderComment(fmt.Sprintf("Code generated by %s. DO NOT EDIT.", pkgQualPath))
+	}
+	for i := 1; i <= n; i++ {
+		genChain(f, i)
+	}
+
+	var buf bytes.Buffer
+	if err := f.Render(&buf); nil != err {
+		log.Fatalf("failed to generate code: %v\n", err)
+	}
+	if err := os.WriteFile(filename, buf.Bytes(), 0644); nil != err {
+		log.Fatalf("failed to write generated code to %q: %v\n", filename, err)
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/xeptore/middle/v6
 
-go 1.21
+go 1.22
 
 require (
 	github.com/dave/jennifer v1.7.0
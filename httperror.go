@@ -0,0 +1,29 @@
+package middle
+
+import "net/http"
+
+// HTTPError is an error that carries the HTTP status code, and message a catch callback is expected to respond with. It is returned by functions of this package that fail due to an invalid request, e.g., [PathParam], so catch callbacks can tell client errors apart from internal ones via [errors.As].
+type HTTPError struct {
+	// Status is the HTTP status code of the response, e.g., [net/http.StatusBadRequest].
+	Status int
+	// Message is a human-readable description of the error that is safe to be sent to the client. [net/http.StatusText] of Status is used if it is empty.
+	Message string
+	// Err is the underlying error, if there is any. It is not meant to be sent to the client.
+	Err error
+}
+
+func (e *HTTPError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.Status)
+	}
+	if nil != e.Err {
+		return message + ": " + e.Err.Error()
+	}
+	return message
+}
+
+// Unwrap returns Err.
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
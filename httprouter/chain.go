@@ -0,0 +1,2870 @@
+package httprouter
+
+import (
+	router "github.com/julienschmidt/httprouter"
+	"github.com/xeptore/middle/v6"
+	wrap "github.com/xeptore/middle/v6/internal/wrap"
+	"net/http"
+)
+
+// ChainHandler1 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler1.Handle], or with an optional chain error handler via [ChainHandler1.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler1 struct {
+	middle.ChainHandler1
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler1) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler1.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler1) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler1.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler1) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler1.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler1) With(options ...middle.Option) ChainHandler1 {
+	chain.ChainHandler1 = chain.ChainHandler1.With(options...)
+	return chain
+}
+
+// Chain1 creates a chain of exactly 1 function that will be executed in order, passing route parameters to each of them.
+func Chain1(f1 func(http.ResponseWriter, *http.Request, router.Params) error) ChainHandler1 {
+	return ChainHandler1{middle.Chain1(wrap.Func(func(response http.ResponseWriter, request *http.Request) error {
+		if wrap.Report(request, f1) {
+			return nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}))}
+}
+
+// ChainHandler2 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler2.Handle], or with an optional chain error handler via [ChainHandler2.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler2[A any] struct {
+	middle.ChainHandler2[A]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler2[A]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler2.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler2[A]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler2.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler2[A]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler2.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler2[A]) With(options ...middle.Option) ChainHandler2[A] {
+	chain.ChainHandler2 = chain.ChainHandler2.With(options...)
+	return chain
+}
+
+// Chain2 creates a chain of exactly 2 functions that will be executed in order, passing route parameters to each of them.
+func Chain2[A any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) error) ChainHandler2[A] {
+	return ChainHandler2[A]{middle.Chain2(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) error {
+		if wrap.Report(request, f2) {
+			return nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}))}
+}
+
+// ChainHandler3 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler3.Handle], or with an optional chain error handler via [ChainHandler3.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler3[A any, B any] struct {
+	middle.ChainHandler3[A, B]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler3[A, B]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler3.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler3[A, B]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler3.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler3[A, B]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler3.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler3[A, B]) With(options ...middle.Option) ChainHandler3[A, B] {
+	chain.ChainHandler3 = chain.ChainHandler3.With(options...)
+	return chain
+}
+
+// Chain3 creates a chain of exactly 3 functions that will be executed in order, passing route parameters to each of them.
+func Chain3[A any, B any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) error) ChainHandler3[A, B] {
+	return ChainHandler3[A, B]{middle.Chain3(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) error {
+		if wrap.Report(request, f3) {
+			return nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}))}
+}
+
+// ChainHandler4 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler4.Handle], or with an optional chain error handler via [ChainHandler4.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler4[A any, B any, C any] struct {
+	middle.ChainHandler4[A, B, C]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler4[A, B, C]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler4.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler4[A, B, C]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler4.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler4[A, B, C]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler4.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler4[A, B, C]) With(options ...middle.Option) ChainHandler4[A, B, C] {
+	chain.ChainHandler4 = chain.ChainHandler4.With(options...)
+	return chain
+}
+
+// Chain4 creates a chain of exactly 4 functions that will be executed in order, passing route parameters to each of them.
+func Chain4[A any, B any, C any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) error) ChainHandler4[A, B, C] {
+	return ChainHandler4[A, B, C]{middle.Chain4(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) error {
+		if wrap.Report(request, f4) {
+			return nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}))}
+}
+
+// ChainHandler5 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler5.Handle], or with an optional chain error handler via [ChainHandler5.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler5[A any, B any, C any, D any] struct {
+	middle.ChainHandler5[A, B, C, D]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler5[A, B, C, D]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler5.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler5[A, B, C, D]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler5.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler5[A, B, C, D]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler5.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler5[A, B, C, D]) With(options ...middle.Option) ChainHandler5[A, B, C, D] {
+	chain.ChainHandler5 = chain.ChainHandler5.With(options...)
+	return chain
+}
+
+// Chain5 creates a chain of exactly 5 functions that will be executed in order, passing route parameters to each of them.
+func Chain5[A any, B any, C any, D any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) error) ChainHandler5[A, B, C, D] {
+	return ChainHandler5[A, B, C, D]{middle.Chain5(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) error {
+		if wrap.Report(request, f5) {
+			return nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}))}
+}
+
+// ChainHandler6 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler6.Handle], or with an optional chain error handler via [ChainHandler6.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler6[A any, B any, C any, D any, E any] struct {
+	middle.ChainHandler6[A, B, C, D, E]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler6[A, B, C, D, E]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler6.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler6[A, B, C, D, E]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler6.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler6[A, B, C, D, E]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler6.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler6[A, B, C, D, E]) With(options ...middle.Option) ChainHandler6[A, B, C, D, E] {
+	chain.ChainHandler6 = chain.ChainHandler6.With(options...)
+	return chain
+}
+
+// Chain6 creates a chain of exactly 6 functions that will be executed in order, passing route parameters to each of them.
+func Chain6[A any, B any, C any, D any, E any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) error) ChainHandler6[A, B, C, D, E] {
+	return ChainHandler6[A, B, C, D, E]{middle.Chain6(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) error {
+		if wrap.Report(request, f6) {
+			return nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}))}
+}
+
+// ChainHandler7 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler7.Handle], or with an optional chain error handler via [ChainHandler7.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler7[A any, B any, C any, D any, E any, F any] struct {
+	middle.ChainHandler7[A, B, C, D, E, F]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler7[A, B, C, D, E, F]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler7.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler7[A, B, C, D, E, F]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler7.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler7[A, B, C, D, E, F]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler7.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler7[A, B, C, D, E, F]) With(options ...middle.Option) ChainHandler7[A, B, C, D, E, F] {
+	chain.ChainHandler7 = chain.ChainHandler7.With(options...)
+	return chain
+}
+
+// Chain7 creates a chain of exactly 7 functions that will be executed in order, passing route parameters to each of them.
+func Chain7[A any, B any, C any, D any, E any, F any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) error) ChainHandler7[A, B, C, D, E, F] {
+	return ChainHandler7[A, B, C, D, E, F]{middle.Chain7(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) error {
+		if wrap.Report(request, f7) {
+			return nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}))}
+}
+
+// ChainHandler8 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler8.Handle], or with an optional chain error handler via [ChainHandler8.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler8[A any, B any, C any, D any, E any, F any, G any] struct {
+	middle.ChainHandler8[A, B, C, D, E, F, G]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler8[A, B, C, D, E, F, G]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler8.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler8[A, B, C, D, E, F, G]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler8.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler8[A, B, C, D, E, F, G]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler8.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler8[A, B, C, D, E, F, G]) With(options ...middle.Option) ChainHandler8[A, B, C, D, E, F, G] {
+	chain.ChainHandler8 = chain.ChainHandler8.With(options...)
+	return chain
+}
+
+// Chain8 creates a chain of exactly 8 functions that will be executed in order, passing route parameters to each of them.
+func Chain8[A any, B any, C any, D any, E any, F any, G any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) error) ChainHandler8[A, B, C, D, E, F, G] {
+	return ChainHandler8[A, B, C, D, E, F, G]{middle.Chain8(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) error {
+		if wrap.Report(request, f8) {
+			return nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}))}
+}
+
+// ChainHandler9 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler9.Handle], or with an optional chain error handler via [ChainHandler9.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler9[A any, B any, C any, D any, E any, F any, G any, H any] struct {
+	middle.ChainHandler9[A, B, C, D, E, F, G, H]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler9[A, B, C, D, E, F, G, H]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler9.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler9[A, B, C, D, E, F, G, H]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler9.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler9[A, B, C, D, E, F, G, H]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler9.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler9[A, B, C, D, E, F, G, H]) With(options ...middle.Option) ChainHandler9[A, B, C, D, E, F, G, H] {
+	chain.ChainHandler9 = chain.ChainHandler9.With(options...)
+	return chain
+}
+
+// Chain9 creates a chain of exactly 9 functions that will be executed in order, passing route parameters to each of them.
+func Chain9[A any, B any, C any, D any, E any, F any, G any, H any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) error) ChainHandler9[A, B, C, D, E, F, G, H] {
+	return ChainHandler9[A, B, C, D, E, F, G, H]{middle.Chain9(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) error {
+		if wrap.Report(request, f9) {
+			return nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}))}
+}
+
+// ChainHandler10 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler10.Handle], or with an optional chain error handler via [ChainHandler10.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler10[A any, B any, C any, D any, E any, F any, G any, H any, I any] struct {
+	middle.ChainHandler10[A, B, C, D, E, F, G, H, I]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler10[A, B, C, D, E, F, G, H, I]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler10.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler10[A, B, C, D, E, F, G, H, I]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler10.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler10[A, B, C, D, E, F, G, H, I]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler10.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler10[A, B, C, D, E, F, G, H, I]) With(options ...middle.Option) ChainHandler10[A, B, C, D, E, F, G, H, I] {
+	chain.ChainHandler10 = chain.ChainHandler10.With(options...)
+	return chain
+}
+
+// Chain10 creates a chain of exactly 10 functions that will be executed in order, passing route parameters to each of them.
+func Chain10[A any, B any, C any, D any, E any, F any, G any, H any, I any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) error) ChainHandler10[A, B, C, D, E, F, G, H, I] {
+	return ChainHandler10[A, B, C, D, E, F, G, H, I]{middle.Chain10(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) error {
+		if wrap.Report(request, f10) {
+			return nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}))}
+}
+
+// ChainHandler11 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler11.Handle], or with an optional chain error handler via [ChainHandler11.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler11[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any] struct {
+	middle.ChainHandler11[A, B, C, D, E, F, G, H, I, J]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler11[A, B, C, D, E, F, G, H, I, J]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler11.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler11[A, B, C, D, E, F, G, H, I, J]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler11.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler11[A, B, C, D, E, F, G, H, I, J]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler11.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler11[A, B, C, D, E, F, G, H, I, J]) With(options ...middle.Option) ChainHandler11[A, B, C, D, E, F, G, H, I, J] {
+	chain.ChainHandler11 = chain.ChainHandler11.With(options...)
+	return chain
+}
+
+// Chain11 creates a chain of exactly 11 functions that will be executed in order, passing route parameters to each of them.
+func Chain11[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) error) ChainHandler11[A, B, C, D, E, F, G, H, I, J] {
+	return ChainHandler11[A, B, C, D, E, F, G, H, I, J]{middle.Chain11(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) error {
+		if wrap.Report(request, f11) {
+			return nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}))}
+}
+
+// ChainHandler12 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler12.Handle], or with an optional chain error handler via [ChainHandler12.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler12[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any] struct {
+	middle.ChainHandler12[A, B, C, D, E, F, G, H, I, J, K]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler12[A, B, C, D, E, F, G, H, I, J, K]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler12.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler12[A, B, C, D, E, F, G, H, I, J, K]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler12.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler12[A, B, C, D, E, F, G, H, I, J, K]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler12.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler12[A, B, C, D, E, F, G, H, I, J, K]) With(options ...middle.Option) ChainHandler12[A, B, C, D, E, F, G, H, I, J, K] {
+	chain.ChainHandler12 = chain.ChainHandler12.With(options...)
+	return chain
+}
+
+// Chain12 creates a chain of exactly 12 functions that will be executed in order, passing route parameters to each of them.
+func Chain12[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) error) ChainHandler12[A, B, C, D, E, F, G, H, I, J, K] {
+	return ChainHandler12[A, B, C, D, E, F, G, H, I, J, K]{middle.Chain12(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) error {
+		if wrap.Report(request, f12) {
+			return nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}))}
+}
+
+// ChainHandler13 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler13.Handle], or with an optional chain error handler via [ChainHandler13.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler13[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any] struct {
+	middle.ChainHandler13[A, B, C, D, E, F, G, H, I, J, K, L]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler13[A, B, C, D, E, F, G, H, I, J, K, L]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler13.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler13[A, B, C, D, E, F, G, H, I, J, K, L]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler13.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler13[A, B, C, D, E, F, G, H, I, J, K, L]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler13.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler13[A, B, C, D, E, F, G, H, I, J, K, L]) With(options ...middle.Option) ChainHandler13[A, B, C, D, E, F, G, H, I, J, K, L] {
+	chain.ChainHandler13 = chain.ChainHandler13.With(options...)
+	return chain
+}
+
+// Chain13 creates a chain of exactly 13 functions that will be executed in order, passing route parameters to each of them.
+func Chain13[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) error) ChainHandler13[A, B, C, D, E, F, G, H, I, J, K, L] {
+	return ChainHandler13[A, B, C, D, E, F, G, H, I, J, K, L]{middle.Chain13(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) error {
+		if wrap.Report(request, f13) {
+			return nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}))}
+}
+
+// ChainHandler14 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler14.Handle], or with an optional chain error handler via [ChainHandler14.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler14[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any] struct {
+	middle.ChainHandler14[A, B, C, D, E, F, G, H, I, J, K, L, M]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler14[A, B, C, D, E, F, G, H, I, J, K, L, M]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler14.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler14[A, B, C, D, E, F, G, H, I, J, K, L, M]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler14.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler14[A, B, C, D, E, F, G, H, I, J, K, L, M]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler14.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler14[A, B, C, D, E, F, G, H, I, J, K, L, M]) With(options ...middle.Option) ChainHandler14[A, B, C, D, E, F, G, H, I, J, K, L, M] {
+	chain.ChainHandler14 = chain.ChainHandler14.With(options...)
+	return chain
+}
+
+// Chain14 creates a chain of exactly 14 functions that will be executed in order, passing route parameters to each of them.
+func Chain14[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) error) ChainHandler14[A, B, C, D, E, F, G, H, I, J, K, L, M] {
+	return ChainHandler14[A, B, C, D, E, F, G, H, I, J, K, L, M]{middle.Chain14(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) error {
+		if wrap.Report(request, f14) {
+			return nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}))}
+}
+
+// ChainHandler15 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler15.Handle], or with an optional chain error handler via [ChainHandler15.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler15[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any] struct {
+	middle.ChainHandler15[A, B, C, D, E, F, G, H, I, J, K, L, M, N]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler15[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler15.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler15[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler15.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler15[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler15.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler15[A, B, C, D, E, F, G, H, I, J, K, L, M, N]) With(options ...middle.Option) ChainHandler15[A, B, C, D, E, F, G, H, I, J, K, L, M, N] {
+	chain.ChainHandler15 = chain.ChainHandler15.With(options...)
+	return chain
+}
+
+// Chain15 creates a chain of exactly 15 functions that will be executed in order, passing route parameters to each of them.
+func Chain15[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) error) ChainHandler15[A, B, C, D, E, F, G, H, I, J, K, L, M, N] {
+	return ChainHandler15[A, B, C, D, E, F, G, H, I, J, K, L, M, N]{middle.Chain15(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) error {
+		if wrap.Report(request, f15) {
+			return nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}))}
+}
+
+// ChainHandler16 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler16.Handle], or with an optional chain error handler via [ChainHandler16.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler16[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any] struct {
+	middle.ChainHandler16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler16.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler16.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler16.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]) With(options ...middle.Option) ChainHandler16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O] {
+	chain.ChainHandler16 = chain.ChainHandler16.With(options...)
+	return chain
+}
+
+// Chain16 creates a chain of exactly 16 functions that will be executed in order, passing route parameters to each of them.
+func Chain16[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) error) ChainHandler16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O] {
+	return ChainHandler16[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O]{middle.Chain16(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) error {
+		if wrap.Report(request, f16) {
+			return nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}))}
+}
+
+// ChainHandler17 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler17.Handle], or with an optional chain error handler via [ChainHandler17.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler17[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any] struct {
+	middle.ChainHandler17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler17.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler17.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler17.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]) With(options ...middle.Option) ChainHandler17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P] {
+	chain.ChainHandler17 = chain.ChainHandler17.With(options...)
+	return chain
+}
+
+// Chain17 creates a chain of exactly 17 functions that will be executed in order, passing route parameters to each of them.
+func Chain17[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) error) ChainHandler17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P] {
+	return ChainHandler17[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P]{middle.Chain17(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) error {
+		if wrap.Report(request, f17) {
+			return nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}))}
+}
+
+// ChainHandler18 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler18.Handle], or with an optional chain error handler via [ChainHandler18.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler18[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any] struct {
+	middle.ChainHandler18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler18.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler18.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler18.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q]) With(options ...middle.Option) ChainHandler18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q] {
+	chain.ChainHandler18 = chain.ChainHandler18.With(options...)
+	return chain
+}
+
+// Chain18 creates a chain of exactly 18 functions that will be executed in order, passing route parameters to each of them.
+func Chain18[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) error) ChainHandler18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q] {
+	return ChainHandler18[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q]{middle.Chain18(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) error {
+		if wrap.Report(request, f18) {
+			return nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}))}
+}
+
+// ChainHandler19 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler19.Handle], or with an optional chain error handler via [ChainHandler19.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler19[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any] struct {
+	middle.ChainHandler19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler19.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler19.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler19.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R]) With(options ...middle.Option) ChainHandler19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R] {
+	chain.ChainHandler19 = chain.ChainHandler19.With(options...)
+	return chain
+}
+
+// Chain19 creates a chain of exactly 19 functions that will be executed in order, passing route parameters to each of them.
+func Chain19[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) error) ChainHandler19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R] {
+	return ChainHandler19[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R]{middle.Chain19(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) error {
+		if wrap.Report(request, f19) {
+			return nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}))}
+}
+
+// ChainHandler20 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler20.Handle], or with an optional chain error handler via [ChainHandler20.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler20[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any] struct {
+	middle.ChainHandler20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler20.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler20.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler20.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S]) With(options ...middle.Option) ChainHandler20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S] {
+	chain.ChainHandler20 = chain.ChainHandler20.With(options...)
+	return chain
+}
+
+// Chain20 creates a chain of exactly 20 functions that will be executed in order, passing route parameters to each of them.
+func Chain20[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) (S, error), f20 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S) error) ChainHandler20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S] {
+	return ChainHandler20[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S]{middle.Chain20(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) (S, error) {
+		if wrap.Report(request, f19) {
+			return *new(S), nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S) error {
+		if wrap.Report(request, f20) {
+			return nil
+		}
+		return f20(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s)
+	}))}
+}
+
+// ChainHandler21 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler21.Handle], or with an optional chain error handler via [ChainHandler21.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler21[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any] struct {
+	middle.ChainHandler21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler21.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler21.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler21.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T]) With(options ...middle.Option) ChainHandler21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T] {
+	chain.ChainHandler21 = chain.ChainHandler21.With(options...)
+	return chain
+}
+
+// Chain21 creates a chain of exactly 21 functions that will be executed in order, passing route parameters to each of them.
+func Chain21[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) (S, error), f20 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S) (T, error), f21 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T) error) ChainHandler21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T] {
+	return ChainHandler21[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T]{middle.Chain21(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) (S, error) {
+		if wrap.Report(request, f19) {
+			return *new(S), nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S) (T, error) {
+		if wrap.Report(request, f20) {
+			return *new(T), nil
+		}
+		return f20(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T) error {
+		if wrap.Report(request, f21) {
+			return nil
+		}
+		return f21(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t)
+	}))}
+}
+
+// ChainHandler22 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler22.Handle], or with an optional chain error handler via [ChainHandler22.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler22[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any] struct {
+	middle.ChainHandler22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler22.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler22.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler22.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U]) With(options ...middle.Option) ChainHandler22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U] {
+	chain.ChainHandler22 = chain.ChainHandler22.With(options...)
+	return chain
+}
+
+// Chain22 creates a chain of exactly 22 functions that will be executed in order, passing route parameters to each of them.
+func Chain22[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) (S, error), f20 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S) (T, error), f21 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T) (U, error), f22 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U) error) ChainHandler22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U] {
+	return ChainHandler22[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U]{middle.Chain22(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) (S, error) {
+		if wrap.Report(request, f19) {
+			return *new(S), nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S) (T, error) {
+		if wrap.Report(request, f20) {
+			return *new(T), nil
+		}
+		return f20(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T) (U, error) {
+		if wrap.Report(request, f21) {
+			return *new(U), nil
+		}
+		return f21(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U) error {
+		if wrap.Report(request, f22) {
+			return nil
+		}
+		return f22(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u)
+	}))}
+}
+
+// ChainHandler23 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler23.Handle], or with an optional chain error handler via [ChainHandler23.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler23[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any] struct {
+	middle.ChainHandler23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler23.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler23.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler23.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V]) With(options ...middle.Option) ChainHandler23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V] {
+	chain.ChainHandler23 = chain.ChainHandler23.With(options...)
+	return chain
+}
+
+// Chain23 creates a chain of exactly 23 functions that will be executed in order, passing route parameters to each of them.
+func Chain23[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) (S, error), f20 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S) (T, error), f21 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T) (U, error), f22 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U) (V, error), f23 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V) error) ChainHandler23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V] {
+	return ChainHandler23[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V]{middle.Chain23(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) (S, error) {
+		if wrap.Report(request, f19) {
+			return *new(S), nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S) (T, error) {
+		if wrap.Report(request, f20) {
+			return *new(T), nil
+		}
+		return f20(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T) (U, error) {
+		if wrap.Report(request, f21) {
+			return *new(U), nil
+		}
+		return f21(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U) (V, error) {
+		if wrap.Report(request, f22) {
+			return *new(V), nil
+		}
+		return f22(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V) error {
+		if wrap.Report(request, f23) {
+			return nil
+		}
+		return f23(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v)
+	}))}
+}
+
+// ChainHandler24 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler24.Handle], or with an optional chain error handler via [ChainHandler24.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler24[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any, W any] struct {
+	middle.ChainHandler24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler24.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler24.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler24.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W]) With(options ...middle.Option) ChainHandler24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W] {
+	chain.ChainHandler24 = chain.ChainHandler24.With(options...)
+	return chain
+}
+
+// Chain24 creates a chain of exactly 24 functions that will be executed in order, passing route parameters to each of them.
+func Chain24[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any, W any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) (S, error), f20 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S) (T, error), f21 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T) (U, error), f22 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U) (V, error), f23 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V) (W, error), f24 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W) error) ChainHandler24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W] {
+	return ChainHandler24[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W]{middle.Chain24(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) (S, error) {
+		if wrap.Report(request, f19) {
+			return *new(S), nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S) (T, error) {
+		if wrap.Report(request, f20) {
+			return *new(T), nil
+		}
+		return f20(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T) (U, error) {
+		if wrap.Report(request, f21) {
+			return *new(U), nil
+		}
+		return f21(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U) (V, error) {
+		if wrap.Report(request, f22) {
+			return *new(V), nil
+		}
+		return f22(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V) (W, error) {
+		if wrap.Report(request, f23) {
+			return *new(W), nil
+		}
+		return f23(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W) error {
+		if wrap.Report(request, f24) {
+			return nil
+		}
+		return f24(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w)
+	}))}
+}
+
+// ChainHandler25 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler25.Handle], or with an optional chain error handler via [ChainHandler25.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler25[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any, W any, X any] struct {
+	middle.ChainHandler25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler25.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler25.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler25.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X]) With(options ...middle.Option) ChainHandler25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X] {
+	chain.ChainHandler25 = chain.ChainHandler25.With(options...)
+	return chain
+}
+
+// Chain25 creates a chain of exactly 25 functions that will be executed in order, passing route parameters to each of them.
+func Chain25[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any, W any, X any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) (S, error), f20 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S) (T, error), f21 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T) (U, error), f22 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U) (V, error), f23 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V) (W, error), f24 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W) (X, error), f25 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X) error) ChainHandler25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X] {
+	return ChainHandler25[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X]{middle.Chain25(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) (S, error) {
+		if wrap.Report(request, f19) {
+			return *new(S), nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S) (T, error) {
+		if wrap.Report(request, f20) {
+			return *new(T), nil
+		}
+		return f20(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T) (U, error) {
+		if wrap.Report(request, f21) {
+			return *new(U), nil
+		}
+		return f21(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U) (V, error) {
+		if wrap.Report(request, f22) {
+			return *new(V), nil
+		}
+		return f22(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V) (W, error) {
+		if wrap.Report(request, f23) {
+			return *new(W), nil
+		}
+		return f23(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W) (X, error) {
+		if wrap.Report(request, f24) {
+			return *new(X), nil
+		}
+		return f24(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W, x X) error {
+		if wrap.Report(request, f25) {
+			return nil
+		}
+		return f25(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, x)
+	}))}
+}
+
+// ChainHandler26 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler26.Handle], or with an optional chain error handler via [ChainHandler26.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler26[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any, W any, X any, Y any] struct {
+	middle.ChainHandler26[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler26[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler26.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler26[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler26.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler26[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler26.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler26[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y]) With(options ...middle.Option) ChainHandler26[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y] {
+	chain.ChainHandler26 = chain.ChainHandler26.With(options...)
+	return chain
+}
+
+// Chain26 creates a chain of exactly 26 functions that will be executed in order, passing route parameters to each of them.
+func Chain26[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any, W any, X any, Y any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) (S, error), f20 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S) (T, error), f21 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T) (U, error), f22 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U) (V, error), f23 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V) (W, error), f24 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W) (X, error), f25 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X) (Y, error), f26 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y) error) ChainHandler26[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y] {
+	return ChainHandler26[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y]{middle.Chain26(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) (S, error) {
+		if wrap.Report(request, f19) {
+			return *new(S), nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S) (T, error) {
+		if wrap.Report(request, f20) {
+			return *new(T), nil
+		}
+		return f20(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T) (U, error) {
+		if wrap.Report(request, f21) {
+			return *new(U), nil
+		}
+		return f21(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U) (V, error) {
+		if wrap.Report(request, f22) {
+			return *new(V), nil
+		}
+		return f22(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V) (W, error) {
+		if wrap.Report(request, f23) {
+			return *new(W), nil
+		}
+		return f23(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W) (X, error) {
+		if wrap.Report(request, f24) {
+			return *new(X), nil
+		}
+		return f24(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W, x X) (Y, error) {
+		if wrap.Report(request, f25) {
+			return *new(Y), nil
+		}
+		return f25(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, x)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W, x X, y Y) error {
+		if wrap.Report(request, f26) {
+			return nil
+		}
+		return f26(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, x, y)
+	}))}
+}
+
+// ChainHandler27 is a chain whose functions receive route parameters matched by [router.Router]. It satisfies [router.Handle] via [ChainHandler27.Handle], or with an optional chain error handler via [ChainHandler27.Finally]. It also satisfies [net/http.Handler] for chains mounted via [router.Router.Handler], and [middle.Chain], so it can be registered on a [middle.Router] created with a [Mux].
+type ChainHandler27[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any, W any, X any, Y any, Z any] struct {
+	middle.ChainHandler27[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z]
+}
+
+// Handle satisfies [router.Handle]. It executes functions in the chain in order, passing params, and results of all previous function calls to each of them, the same way [middle.ChainHandler1.ServeHTTP], and the like do.
+func (chain ChainHandler27[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z]) Handle(response http.ResponseWriter, request *http.Request, params router.Params) {
+	chain.ChainHandler27.ServeHTTP(response, withParams(request, params))
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing route parameters stored in the request context by [router.Router.Handler] to each of them.
+func (chain ChainHandler27[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.ChainHandler27.ServeHTTP(response, request)
+}
+
+// Finally returns a [router.Handle] that executes functions in the chain in order, and calls catch with the route parameters, and the error if any of them fails, the same way [middle.ChainHandler1.Finally], and the like do.
+func (chain ChainHandler27[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z]) Finally(catch func(http.ResponseWriter, *http.Request, router.Params, error)) router.Handle {
+	handler := chain.ChainHandler27.Finally(func(response http.ResponseWriter, request *http.Request, err error) {
+		catch(response, request, router.ParamsFromContext(request.Context()), err)
+	})
+	return func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler(response, withParams(request, params))
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain ChainHandler27[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z]) With(options ...middle.Option) ChainHandler27[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z] {
+	chain.ChainHandler27 = chain.ChainHandler27.With(options...)
+	return chain
+}
+
+// Chain27 creates a chain of exactly 27 functions that will be executed in order, passing route parameters to each of them.
+func Chain27[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any, K any, L any, M any, N any, O any, P any, Q any, R any, S any, T any, U any, V any, W any, X any, Y any, Z any](f1 func(http.ResponseWriter, *http.Request, router.Params) (A, error), f2 func(http.ResponseWriter, *http.Request, router.Params, A) (B, error), f3 func(http.ResponseWriter, *http.Request, router.Params, A, B) (C, error), f4 func(http.ResponseWriter, *http.Request, router.Params, A, B, C) (D, error), f5 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D) (E, error), f6 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E) (F, error), f7 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F) (G, error), f8 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G) (H, error), f9 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H) (I, error), f10 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I) (J, error), f11 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J) (K, error), f12 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K) (L, error), f13 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L) (M, error), f14 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M) (N, error), f15 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N) (O, error), f16 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O) (P, error), f17 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P) (Q, error), f18 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q) (R, error), f19 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R) (S, error), f20 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S) (T, error), f21 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T) (U, error), f22 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U) (V, error), f23 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V) (W, error), f24 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W) (X, error), f25 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X) (Y, error), f26 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y) (Z, error), f27 func(http.ResponseWriter, *http.Request, router.Params, A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z) error) ChainHandler27[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z] {
+	return ChainHandler27[A, B, C, D, E, F, G, H, I, J, K, L, M, N, O, P, Q, R, S, T, U, V, W, X, Y, Z]{middle.Chain27(wrap.Func(func(response http.ResponseWriter, request *http.Request) (A, error) {
+		if wrap.Report(request, f1) {
+			return *new(A), nil
+		}
+		return f1(response, request, router.ParamsFromContext(request.Context()))
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A) (B, error) {
+		if wrap.Report(request, f2) {
+			return *new(B), nil
+		}
+		return f2(response, request, router.ParamsFromContext(request.Context()), a)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B) (C, error) {
+		if wrap.Report(request, f3) {
+			return *new(C), nil
+		}
+		return f3(response, request, router.ParamsFromContext(request.Context()), a, b)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C) (D, error) {
+		if wrap.Report(request, f4) {
+			return *new(D), nil
+		}
+		return f4(response, request, router.ParamsFromContext(request.Context()), a, b, c)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D) (E, error) {
+		if wrap.Report(request, f5) {
+			return *new(E), nil
+		}
+		return f5(response, request, router.ParamsFromContext(request.Context()), a, b, c, d)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E) (F, error) {
+		if wrap.Report(request, f6) {
+			return *new(F), nil
+		}
+		return f6(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F) (G, error) {
+		if wrap.Report(request, f7) {
+			return *new(G), nil
+		}
+		return f7(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G) (H, error) {
+		if wrap.Report(request, f8) {
+			return *new(H), nil
+		}
+		return f8(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H) (I, error) {
+		if wrap.Report(request, f9) {
+			return *new(I), nil
+		}
+		return f9(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I) (J, error) {
+		if wrap.Report(request, f10) {
+			return *new(J), nil
+		}
+		return f10(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J) (K, error) {
+		if wrap.Report(request, f11) {
+			return *new(K), nil
+		}
+		return f11(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K) (L, error) {
+		if wrap.Report(request, f12) {
+			return *new(L), nil
+		}
+		return f12(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L) (M, error) {
+		if wrap.Report(request, f13) {
+			return *new(M), nil
+		}
+		return f13(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M) (N, error) {
+		if wrap.Report(request, f14) {
+			return *new(N), nil
+		}
+		return f14(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N) (O, error) {
+		if wrap.Report(request, f15) {
+			return *new(O), nil
+		}
+		return f15(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O) (P, error) {
+		if wrap.Report(request, f16) {
+			return *new(P), nil
+		}
+		return f16(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P) (Q, error) {
+		if wrap.Report(request, f17) {
+			return *new(Q), nil
+		}
+		return f17(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q) (R, error) {
+		if wrap.Report(request, f18) {
+			return *new(R), nil
+		}
+		return f18(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R) (S, error) {
+		if wrap.Report(request, f19) {
+			return *new(S), nil
+		}
+		return f19(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S) (T, error) {
+		if wrap.Report(request, f20) {
+			return *new(T), nil
+		}
+		return f20(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T) (U, error) {
+		if wrap.Report(request, f21) {
+			return *new(U), nil
+		}
+		return f21(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U) (V, error) {
+		if wrap.Report(request, f22) {
+			return *new(V), nil
+		}
+		return f22(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V) (W, error) {
+		if wrap.Report(request, f23) {
+			return *new(W), nil
+		}
+		return f23(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W) (X, error) {
+		if wrap.Report(request, f24) {
+			return *new(X), nil
+		}
+		return f24(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W, x X) (Y, error) {
+		if wrap.Report(request, f25) {
+			return *new(Y), nil
+		}
+		return f25(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, x)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W, x X, y Y) (Z, error) {
+		if wrap.Report(request, f26) {
+			return *new(Z), nil
+		}
+		return f26(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, x, y)
+	}), wrap.Func(func(response http.ResponseWriter, request *http.Request, a A, b B, c C, d D, e E, f F, g G, h H, i I, j J, k K, l L, m M, n N, o O, p P, q Q, r R, s S, t T, u U, v V, w W, x X, y Y, z Z) error {
+		if wrap.Report(request, f27) {
+			return nil
+		}
+		return f27(response, request, router.ParamsFromContext(request.Context()), a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, x, y, z)
+	}))}
+}
@@ -0,0 +1,4 @@
+// Package httprouter provides chains whose functions receive route parameters matched by [github.com/julienschmidt/httprouter.Router], so they do not need to retrieve them from the request context.
+package httprouter
+
+//go:generate go run github.com/xeptore/middle/v6/gen/httprouter -file chain.go -no-header
@@ -0,0 +1,72 @@
+package httprouter
+
+import (
+	"net/http"
+	"strings"
+
+	router "github.com/julienschmidt/httprouter"
+	"github.com/xeptore/middle/v6"
+)
+
+// methods are the methods chains registered via [Mux] without a method are registered for, as [router.Router] requires a method for every route.
+var methods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodConnect,
+	http.MethodOptions,
+	http.MethodTrace,
+}
+
+type mux struct {
+	router *router.Router
+}
+
+// Mux adapts r to [middle.Mux], so that it can be used by a [middle.Router]. Patterns are converted from [net/http.ServeMux] syntax to httprouter syntax, i.e., "GET /files/{id}/{path...}" is registered as "/files/:id/*path" for GET requests, and "{$}" segments are dropped, as httprouter matches paths exactly. Patterns without a method are registered for all standard methods. Route parameters are passed to the handler the same way [ChainHandler1.Handle] passes them.
+func Mux(r *router.Router) middle.Mux {
+	return mux{router: r}
+}
+
+func (m mux) Handle(pattern string, handler http.Handler) {
+	method, path, ok := strings.Cut(strings.TrimSpace(pattern), " ")
+	if !ok {
+		method, path = "", method
+	}
+	path = routerPath(strings.TrimSpace(path))
+	handle := func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		handler.ServeHTTP(response, withParams(request, params))
+	}
+	if method != "" {
+		m.router.Handle(method, path, handle)
+		return
+	}
+	for _, method := range methods {
+		m.router.Handle(method, path, handle)
+	}
+}
+
+// routerPath converts path from [net/http.ServeMux] pattern syntax to httprouter path syntax.
+func routerPath(path string) string {
+	segments := strings.Split(path, "/")
+	converted := segments[:0]
+	for _, segment := range segments {
+		name, ok := strings.CutPrefix(segment, "{")
+		if !ok {
+			converted = append(converted, segment)
+			continue
+		}
+		name = strings.TrimSuffix(name, "}")
+		switch {
+		case name == "$":
+			converted = append(converted, "")
+		case strings.HasSuffix(name, "..."):
+			converted = append(converted, "*"+strings.TrimSuffix(name, "..."))
+		default:
+			converted = append(converted, ":"+name)
+		}
+	}
+	return strings.Join(converted, "/")
+}
@@ -0,0 +1,65 @@
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	router "github.com/julienschmidt/httprouter"
+	"github.com/xeptore/middle/v6"
+)
+
+func TestRouterPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/", "/"},
+		{"/users", "/users"},
+		{"/users/{id}", "/users/:id"},
+		{"/users/{id}/posts/{post}", "/users/:id/posts/:post"},
+		{"/files/{path...}", "/files/*path"},
+		{"/files/{id}/{path...}", "/files/:id/*path"},
+		{"/users/{$}", "/users/"},
+		{"/{$}", "/"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			if got := routerPath(test.path); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestMuxRegistersPatterns(t *testing.T) {
+	r := router.New()
+	var served []string
+	routes := middle.NewRouter(Mux(r), nil)
+	routes.Get("/users/{id}", middle.Chain1(func(_ http.ResponseWriter, request *http.Request) error {
+		served = append(served, "user "+request.PathValue("id"))
+		return nil
+	}))
+	routes.Handle("", "/files/{path...}", middle.Chain1(func(_ http.ResponseWriter, request *http.Request) error {
+		served = append(served, request.Method+" file "+request.PathValue("path"))
+		return nil
+	}))
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/users/42", nil),
+		httptest.NewRequest(http.MethodPost, "/users/42", nil),
+		httptest.NewRequest(http.MethodGet, "/files/a/b", nil),
+		httptest.NewRequest(http.MethodDelete, "/files/c", nil),
+	}
+	for _, request := range requests {
+		r.ServeHTTP(httptest.NewRecorder(), request)
+	}
+	want := []string{"user 42", "GET file a/b", "DELETE file c"}
+	if len(served) != len(want) {
+		t.Fatalf("expected %v to be served, got %v", want, served)
+	}
+	for i := range want {
+		if served[i] != want[i] {
+			t.Errorf("expected %q, got %q", want[i], served[i])
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package httprouter
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	router "github.com/julienschmidt/httprouter"
+	"github.com/xeptore/middle/v6"
+	"github.com/xeptore/middle/v6/internal/wrap"
+)
+
+// withParams returns a shallow copy of request whose context holds params, the same way [router.Router.Handler] stores them, so they can be retrieved via [router.ParamsFromContext]. Params are set as the request path values as well, so they can be retrieved via [net/http.Request.PathValue], e.g., by [middle.PathParam]. Catch-all params are set without their leading slash, the same way [net/http.ServeMux] sets values of wildcards ending in "...", so path values do not depend on the router.
+func withParams(request *http.Request, params router.Params) *http.Request {
+	request = request.WithContext(context.WithValue(request.Context(), router.ParamsKey, params))
+	for _, param := range params {
+		// Only catch-all params start with a slash, as named params match a single non-empty path segment.
+		request.SetPathValue(param.Key, strings.TrimPrefix(param.Value, "/"))
+	}
+	return request
+}
+
+// PathParam is like [middle.PathParam], but creates a function that can be used as the first function of chains created via [Chain2], and the like.
+func PathParam[T any](name string) func(http.ResponseWriter, *http.Request, router.Params) (T, error) {
+	return ignoreParams(middle.PathParam[T](name))
+}
+
+// PathParams is like [middle.PathParams], but creates a function that can be used as the first function of chains created via [Chain2], and the like.
+func PathParams[T any]() func(http.ResponseWriter, *http.Request, router.Params) (T, error) {
+	return ignoreParams(middle.PathParams[T]())
+}
+
+// ignoreParams adapts fn to be used as the first function of chains, recorded as its wrapper, so the chains are described by fn.
+func ignoreParams[T any](fn func(http.ResponseWriter, *http.Request) (T, error)) func(http.ResponseWriter, *http.Request, router.Params) (T, error) {
+	return wrap.Func(func(response http.ResponseWriter, request *http.Request, _ router.Params) (T, error) {
+		if wrap.Report(request, fn) {
+			return *new(T), nil
+		}
+		return fn(response, request)
+	})
+}
@@ -0,0 +1,75 @@
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	router "github.com/julienschmidt/httprouter"
+	"github.com/xeptore/middle/v6"
+)
+
+func TestPathValuesMatchServeMux(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/files/a/b", "a/b"},
+		{"/files/a", "a"},
+		{"/files/", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			param := middle.PathParam[string]("path")
+
+			mux := http.NewServeMux()
+			var muxValue string
+			mux.HandleFunc("/files/{path...}", func(response http.ResponseWriter, request *http.Request) {
+				muxValue, _ = param(response, request)
+			})
+			mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, test.path, nil))
+
+			r := router.New()
+			var routerValue string
+			r.GET("/files/*path", func(response http.ResponseWriter, request *http.Request, params router.Params) {
+				routerValue, _ = param(response, withParams(request, params))
+			})
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, test.path, nil))
+
+			if muxValue != test.expected || routerValue != test.expected {
+				t.Errorf("expected %q, got %q with net/http.ServeMux, and %q with httprouter", test.expected, muxValue, routerValue)
+			}
+		})
+	}
+}
+
+func TestNamedParamsAreSetAsPathValues(t *testing.T) {
+	r := router.New()
+	var id int
+	r.GET("/users/:id", func(response http.ResponseWriter, request *http.Request, params router.Params) {
+		id, _ = middle.PathParam[int]("id")(response, withParams(request, params))
+	})
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42", nil))
+	if id != 42 {
+		t.Errorf("expected 42, got %d", id)
+	}
+}
+
+func authorize(http.ResponseWriter, *http.Request, router.Params, int) (string, error) {
+	return "", nil
+}
+
+func TestRegisteredChainsAreDescribedByTheirFunctions(t *testing.T) {
+	r := middle.NewRouter(Mux(router.New()), nil)
+	r.Get("/users/:id", Chain3(PathParam[int]("id"), authorize, func(http.ResponseWriter, *http.Request, router.Params, int, string) error {
+		return nil
+	}))
+	steps := r.Routes()[0].Steps
+	if !strings.HasPrefix(steps[0].Name, "github.com/xeptore/middle/v6.PathParam[") {
+		t.Errorf("expected first step to be described by middle.PathParam, got %q", steps[0].Name)
+	}
+	if !strings.HasSuffix(steps[1].Name, "httprouter.authorize") || steps[1].String() != "func(http.ResponseWriter, *http.Request, httprouter.Params, int) (string, error)" {
+		t.Errorf("expected second step to be described by authorize, got %q of type %s", steps[1].Name, steps[1])
+	}
+}
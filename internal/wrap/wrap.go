@@ -0,0 +1,76 @@
+// Package wrap records functions that wrap other functions, e.g., ones created via [github.com/xeptore/middle/v6.Lift2], so that chains can be described by the functions they wrap.
+//
+// A wrapper is a function literal registered via [Func] that calls [Report] with its request, and the function it wraps before doing anything else, and returns zero values right away if it reports true, e.g.:
+//
+//	return wrap.Func(func(response http.ResponseWriter, request *http.Request, _ A) (B, error) {
+//		if wrap.Report(request, fn) {
+//			return *new(B), nil
+//		}
+//		return fn(response, request)
+//	})
+//
+// [Unwrap] calls registered wrappers with a probe request, which makes them report the function they wrap instead of calling it.
+package wrap
+
+import (
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+var (
+	// literals holds code pointers of function literals registered via [Func], which are shared by all of the closures created by a literal, so it does not grow with the number of wrappers created.
+	literals sync.Map
+	// probe is the request [Unwrap] calls wrappers with.
+	probe = new(http.Request)
+	// mu serializes calls of wrappers by [Unwrap], so that reported holds the function reported by the wrapper being called.
+	mu       sync.Mutex
+	reported any
+)
+
+var requestType = reflect.TypeOf(probe)
+
+// Func registers wrapper as a function literal whose closures call [Report] first, and returns it. It must only be called with function literals that do, as [Unwrap] calls them.
+func Func[F any](wrapper F) F {
+	pc := reflect.ValueOf(wrapper).Pointer()
+	if _, ok := literals.Load(pc); !ok {
+		literals.Store(pc, struct{}{})
+	}
+	return wrapper
+}
+
+// Report reports whether request is the probe [Unwrap] calls wrappers with, in which case it records fn as the function the wrapper wraps, and the wrapper must return right away without calling fn.
+func Report(request *http.Request, fn any) bool {
+	if request != probe {
+		return false
+	}
+	reported = fn
+	return true
+}
+
+// Unwrap returns the function fn wraps, and reports whether fn is a wrapper registered via [Func].
+func Unwrap(fn any) (any, bool) {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return nil, false
+	}
+	if _, ok := literals.Load(v.Pointer()); !ok {
+		return nil, false
+	}
+	typ := v.Type()
+	args := make([]reflect.Value, typ.NumIn())
+	for i := range args {
+		if typ.In(i) == requestType {
+			args[i] = reflect.ValueOf(probe)
+		} else {
+			args[i] = reflect.Zero(typ.In(i))
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	reported = nil
+	v.Call(args)
+	wrapped := reported
+	reported = nil
+	return wrapped, nil != wrapped
+}
@@ -0,0 +1,79 @@
+package wrap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func wrapped(http.ResponseWriter, *http.Request) (int, error) {
+	return 42, nil
+}
+
+func lift(fn func(http.ResponseWriter, *http.Request) (int, error)) func(http.ResponseWriter, *http.Request, string) (int, error) {
+	return Func(func(response http.ResponseWriter, request *http.Request, _ string) (int, error) {
+		if Report(request, fn) {
+			return 0, nil
+		}
+		return fn(response, request)
+	})
+}
+
+func same(a, b any) bool {
+	return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+}
+
+func TestUnwrapReturnsWrappedFunction(t *testing.T) {
+	fn, ok := Unwrap(lift(wrapped))
+	if !ok || !same(fn, wrapped) {
+		t.Errorf("expected wrapped function to be returned, got %v, and %t", fn, ok)
+	}
+}
+
+func TestUnwrapIgnoresOtherFunctions(t *testing.T) {
+	called := false
+	closure := func(http.ResponseWriter, *http.Request) (int, error) {
+		called = true
+		return 0, nil
+	}
+	for _, fn := range []any{wrapped, closure, (func())(nil), nil, 42} {
+		if _, ok := Unwrap(fn); ok {
+			t.Errorf("expected %T not to be unwrapped", fn)
+		}
+	}
+	if called {
+		t.Error("expected functions not registered as wrappers not to be called")
+	}
+}
+
+func TestWrappersCallWrappedFunction(t *testing.T) {
+	got, err := lift(wrapped)(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), "")
+	if nil != err || got != 42 {
+		t.Errorf("expected wrapped function results, got %d, and %v", got, err)
+	}
+}
+
+func TestUnwrapDistinguishesClosures(t *testing.T) {
+	other := func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, nil
+	}
+	first, second := lift(wrapped), lift(other)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if fn, _ := Unwrap(first); !same(fn, wrapped) {
+					t.Error("expected first wrapper to report its function")
+				}
+				if fn, _ := Unwrap(second); !same(fn, other) {
+					t.Error("expected second wrapper to report its function")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,103 @@
+package middle
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Key is a typed context key, that values of type T are stored under, and retrieved from contexts. Keys are compared by identity, so two keys created via [NewKey] never collide, even if they have the same name. It is usually declared as a package-level variable, and used by code that is called by chain functions, and does not have access to their results otherwise, e.g., repositories, and templates. Results of chain functions can be stored under keys via [Store] option.
+type Key[T any] struct {
+	name string
+}
+
+// NewKey creates a [Key] that values of type T are stored under. name is used in panic messages, and string representation of the key only.
+func NewKey[T any](name string) *Key[T] {
+	return &Key[T]{name: name}
+}
+
+// String returns name of the key.
+func (k *Key[T]) String() string {
+	return k.name
+}
+
+// With returns a copy of ctx that holds v under the key.
+func (k *Key[T]) With(ctx context.Context, v T) context.Context {
+	return context.WithValue(ctx, k, v)
+}
+
+// From returns the value stored under the key in ctx, and reports whether there is any.
+func (k *Key[T]) From(ctx context.Context) (T, bool) {
+	v, ok := ctx.Value(k).(T)
+	return v, ok
+}
+
+// Must returns the value stored under the key in ctx. It panics if there is none.
+func (k *Key[T]) Must(ctx context.Context) T {
+	v, ok := k.From(ctx)
+	if !ok {
+		panic(fmt.Sprintf("middle: no value is stored under key %q in the context", k.name))
+	}
+	return v
+}
+
+// Store makes the chain store result of its step-th (1-based) function under key, so that the rest of its functions, and the catch callback receive a request whose context holds the result. Applying the option to a chain, e.g., via [ChainHandler2.With], panics if the chain has no such function, or its result can not be stored under key, i.e., it is not assignable to T.
+func Store[T any](step int, key *Key[T]) Option {
+	return func(c *config) {
+		if nil == c.stores {
+			c.stores = make(map[int][]store)
+		}
+		c.stores[step] = append(c.stores[step], store{
+			key: key.name,
+			typ: reflect.TypeFor[T](),
+			with: func(ctx context.Context, v any) context.Context {
+				// Results are checked to be assignable to T when the option is applied, so it only fails for nil interface values, which are stored as such.
+				t, _ := v.(T)
+				return key.With(ctx, t)
+			},
+		})
+	}
+}
+
+// store stores results of a chain function under a key set via [Store] option.
+type store struct {
+	// key is name of the key, used to describe the option only.
+	key string
+	// typ is the type of values stored under the key, which results of the function must be assignable to.
+	typ  reflect.Type
+	with func(ctx context.Context, v any) context.Context
+}
+
+// check panics if a function result can not be stored under a key set via [Store] option for it, i.e., steps has no function at the option position, the function has no result, or its result type is not assignable to the key type. It returns c with positions of functions created via [Adapt] among steps recorded, so it can be chained with [config.with].
+func (c *config) check(steps []StepInfo) *config {
+	if nil == c {
+		return c.adapting(steps)
+	}
+	for _, step := range sortedKeys(c.stores) {
+		for _, store := range c.stores[step] {
+			if step < 1 || step > len(steps) {
+				panic(fmt.Sprintf("middle: can not store result of function %d under key %q, as the chain has %d functions", step, store.key, len(steps)))
+			}
+			typ := steps[step-1].Type
+			if typ.NumOut() < 2 {
+				panic(fmt.Sprintf("middle: can not store result of function %d under key %q, as it returns no result", step, store.key))
+			}
+			if !typ.Out(0).AssignableTo(store.typ) {
+				panic(fmt.Sprintf("middle: can not store result of function %d of type %s under key %q of type %s", step, typ.Out(0), store.key, store.typ))
+			}
+		}
+	}
+	return c.adapting(steps)
+}
+
+// keep stores v, the result of the current function in the chain, under keys configured for the function via [Store] option, if there is any.
+func keep[T any](exec *execution, v T) {
+	if nil == exec.config || len(exec.config.stores[exec.step]) == 0 {
+		return
+	}
+	ctx := exec.base.Context()
+	for _, store := range exec.config.stores[exec.step] {
+		ctx = store.with(ctx, v)
+	}
+	exec.base = exec.base.WithContext(ctx)
+}
@@ -0,0 +1,61 @@
+package middle
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStoreStoresResult(t *testing.T) {
+	key := NewKey[string]("user")
+	handler := Chain2(func(http.ResponseWriter, *http.Request) (string, error) {
+		return "gopher", nil
+	}, func(_ http.ResponseWriter, request *http.Request, _ string) error {
+		if user, ok := key.From(request.Context()); !ok || user != "gopher" {
+			t.Errorf("expected %q to be stored under the key, got %q", "gopher", user)
+		}
+		return nil
+	}).With(Store(1, key))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
+func TestStorePanicsOnConstruction(t *testing.T) {
+	chain := Chain2(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, nil
+	}, func(http.ResponseWriter, *http.Request, int) error {
+		return nil
+	})
+	tests := []struct {
+		name   string
+		option Option
+		panic  string
+	}{
+		{"type mismatch", Store(1, NewKey[string]("user")), `of type int under key "user" of type string`},
+		{"no result", Store(2, NewKey[int]("id")), "as it returns no result"},
+		{"no function", Store(3, NewKey[int]("id")), "as the chain has 2 functions"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				recovered, _ := recover().(string)
+				if !strings.Contains(recovered, test.panic) {
+					t.Errorf("expected panic containing %q, got %q", test.panic, recovered)
+				}
+			}()
+			chain.With(test.option)
+		})
+	}
+}
+
+func TestStorePanicsOnPrefixThen(t *testing.T) {
+	prefix := Prefix1(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, nil
+	}).With(Store(1, NewKey[string]("user")))
+	defer func() {
+		if nil == recover() {
+			t.Error("expected a panic")
+		}
+	}()
+	prefix.Then(func(http.ResponseWriter, *http.Request, int) error { return nil })
+}
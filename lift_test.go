@@ -0,0 +1,62 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLiftAdaptsFunctions(t *testing.T) {
+	errFailed := errors.New("failed")
+	var got []any
+	handler := Chain4(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 1, nil
+	}, Lift2[int](func(http.ResponseWriter, *http.Request) (string, error) {
+		return "two", nil
+	}), LiftLast3[int](func(_ http.ResponseWriter, _ *http.Request, b string) (bool, error) {
+		got = append(got, b)
+		return true, nil
+	}), LiftLastHandler4[int, string](func(_ http.ResponseWriter, _ *http.Request, c bool) error {
+		got = append(got, c)
+		return errFailed
+	})).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		if !errors.Is(err, errFailed) {
+			t.Errorf("expected error to be %v, got %v", errFailed, err)
+		}
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if len(got) != 2 || got[0] != "two" || got[1] != true {
+		t.Errorf("expected results %v, got %v", []any{"two", true}, got)
+	}
+}
+
+func TestLiftHandlerIgnoresResults(t *testing.T) {
+	called := false
+	handler := Chain2(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 1, nil
+	}, LiftHandler2[int](func(response http.ResponseWriter, _ *http.Request) error {
+		called = true
+		response.WriteHeader(http.StatusNoContent)
+		return nil
+	}))
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if !called || response.Code != http.StatusNoContent {
+		t.Errorf("expected handler to be called, and status %d, got called %t, and %d", http.StatusNoContent, called, response.Code)
+	}
+}
+
+func TestLiftPassesRequest(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	response := httptest.NewRecorder()
+	lifted := Lift3[int, string](func(w http.ResponseWriter, r *http.Request) (bool, error) {
+		if w != response || r != request {
+			t.Error("expected response, and request to be passed as is")
+		}
+		return true, ErrAbort
+	})
+	if c, err := lifted(response, request, 1, "two"); !c || err != ErrAbort {
+		t.Errorf("expected results to be returned as is, got %t, and %v", c, err)
+	}
+}
@@ -0,0 +1,68 @@
+package middle
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+// RequestIDHeader is the request header [AccessLog] reads request identifier from, if its RequestID is not set.
+const RequestIDHeader = "X-Request-Id"
+
+// AccessLog writes structured access log records via [log/slog]. It satisfies [Observer], so it can be registered on chains via [Observe] option, and its [AccessLog.Step] method can be used as the first function in a chain to provide later functions with a request-scoped logger.
+type AccessLog struct {
+	// Logger is the logger records are written to. It must be non-nil.
+	Logger *slog.Logger
+	// RequestID extracts the request identifier from the request. If it is nil, value of [RequestIDHeader] request header is used.
+	RequestID func(*http.Request) string
+}
+
+func (l AccessLog) requestID(request *http.Request) string {
+	if nil != l.RequestID {
+		return l.RequestID(request)
+	}
+	return request.Header.Get(RequestIDHeader)
+}
+
+// attrs returns request-scoped attributes both access log records, and the loggers returned by [AccessLog.Step] are annotated with.
+func (l AccessLog) attrs(request *http.Request) []any {
+	attrs := []any{
+		slog.String("method", request.Method),
+		slog.String("path", request.URL.Path),
+	}
+	if id := l.requestID(request); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	return attrs
+}
+
+// Step returns a child of l.Logger annotated with request method, path, and identifier, so that later functions in the chain can log with the same attributes access log record is written with.
+func (l AccessLog) Step(_ http.ResponseWriter, request *http.Request) (*slog.Logger, error) {
+	return l.Logger.With(l.attrs(request)...), nil
+}
+
+// Observe satisfies [Observer]. It writes an access log record for the finished chain execution, at [log/slog.LevelError] level if the execution failed, or panicked, and at [log/slog.LevelInfo] level otherwise.
+func (l AccessLog) Observe(report Report) {
+	level := slog.LevelInfo
+	attrs := l.attrs(report.Request)
+	if report.Route != "" {
+		attrs = append(attrs, slog.String("route", report.Route))
+	}
+	attrs = append(
+		attrs,
+		slog.Int("status", report.Status),
+		slog.Int64("bytes", report.Bytes),
+		slog.Duration("duration", report.Duration),
+		slog.String("outcome", report.Outcome.String()),
+	)
+	switch report.Outcome {
+	case OutcomeAbort, OutcomeClientGone:
+		attrs = append(attrs, slog.Int("step", report.Step))
+	case OutcomeError:
+		level = slog.LevelError
+		attrs = append(attrs, slog.Int("step", report.Step), slog.Any("error", report.Err))
+	case OutcomePanic:
+		level = slog.LevelError
+		attrs = append(attrs, slog.Int("step", report.Step), slog.Any("panic", report.Panic))
+	}
+	l.Logger.Log(report.Request.Context(), level, "request", attrs...)
+}
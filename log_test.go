@@ -0,0 +1,138 @@
+package middle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// records decodes JSON log records written to buf.
+func records(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		var record map[string]any
+		if err := decoder.Decode(&record); nil != err {
+			t.Fatalf("expected log records to be valid JSON, got %v", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestAccessLogObserve(t *testing.T) {
+	errFailed := errors.New("failed")
+	tests := []struct {
+		name    string
+		report  Report
+		level   string
+		outcome string
+		extra   map[string]any
+		absent  []string
+	}{
+		{
+			name:    "ok",
+			report:  Report{Status: http.StatusCreated, Bytes: 7, Step: 2, Outcome: OutcomeOK},
+			level:   "INFO",
+			outcome: "ok",
+			absent:  []string{"step", "error", "panic"},
+		},
+		{
+			name:    "abort",
+			report:  Report{Status: http.StatusForbidden, Step: 1, Outcome: OutcomeAbort},
+			level:   "INFO",
+			outcome: "abort",
+			extra:   map[string]any{"step": float64(1)},
+			absent:  []string{"error"},
+		},
+		{
+			name:    "error",
+			report:  Report{Status: http.StatusInternalServerError, Step: 2, Outcome: OutcomeError, Err: errFailed},
+			level:   "ERROR",
+			outcome: "error",
+			extra:   map[string]any{"step": float64(2), "error": "failed"},
+		},
+		{
+			name:    "panic",
+			report:  Report{Status: http.StatusInternalServerError, Step: 1, Outcome: OutcomePanic, Panic: "boom"},
+			level:   "ERROR",
+			outcome: "panic",
+			extra:   map[string]any{"step": float64(1), "panic": "boom"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			log := AccessLog{Logger: slog.New(slog.NewJSONHandler(&buf, nil))}
+			request := httptest.NewRequest(http.MethodPost, "/users", nil)
+			request.Header.Set(RequestIDHeader, "42")
+			test.report.Request, test.report.Route = request, "POST /users"
+			log.Observe(test.report)
+
+			got := records(t, &buf)
+			if len(got) != 1 {
+				t.Fatalf("expected 1 record, got %d", len(got))
+			}
+			record := got[0]
+			expected := map[string]any{
+				"level":      test.level,
+				"msg":        "request",
+				"method":     http.MethodPost,
+				"path":       "/users",
+				"request_id": "42",
+				"route":      "POST /users",
+				"status":     float64(test.report.Status),
+				"bytes":      float64(test.report.Bytes),
+				"outcome":    test.outcome,
+			}
+			for key, value := range test.extra {
+				expected[key] = value
+			}
+			for key, value := range expected {
+				if record[key] != value {
+					t.Errorf("expected %s to be %v, got %v", key, value, record[key])
+				}
+			}
+			for _, key := range test.absent {
+				if _, ok := record[key]; ok {
+					t.Errorf("expected no %s attribute, got %v", key, record[key])
+				}
+			}
+		})
+	}
+}
+
+func TestAccessLogRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	log := AccessLog{
+		Logger:    slog.New(slog.NewJSONHandler(&buf, nil)),
+		RequestID: func(*http.Request) string { return "custom" },
+	}
+	logger, err := log.Step(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if nil != err {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	logger.Info("hello")
+	if record := records(t, &buf)[0]; record["request_id"] != "custom" || record["method"] != http.MethodGet || record["path"] != "/" {
+		t.Errorf("expected step logger to be annotated with request attributes, got %v", record)
+	}
+}
+
+func TestAccessLogObservesChains(t *testing.T) {
+	var buf bytes.Buffer
+	log := AccessLog{Logger: slog.New(slog.NewJSONHandler(&buf, nil))}
+	handler := Chain1(func(response http.ResponseWriter, _ *http.Request) error {
+		_, _ = response.Write([]byte("hello"))
+		return nil
+	}).With(Observe(log))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	got := records(t, &buf)
+	if len(got) != 1 || got[0]["status"] != float64(http.StatusOK) || got[0]["bytes"] != float64(5) || got[0]["outcome"] != "ok" {
+		t.Errorf("expected a record of the successful execution, got %v", got)
+	}
+}
@@ -0,0 +1,75 @@
+package middle
+
+import (
+	"net/http"
+	"time"
+)
+
+// Outcome describes how a chain execution finished.
+type Outcome int
+
+const (
+	// OutcomeOK means all the functions in the chain were executed without an error.
+	OutcomeOK Outcome = iota
+	// OutcomeAbort means a function in the chain returned [ErrAbort].
+	OutcomeAbort
+	// OutcomeError means a function in the chain returned a non-nil error other than [ErrAbort].
+	OutcomeError
+	// OutcomePanic means a function in the chain panicked.
+	OutcomePanic
+	// OutcomeClientGone means the chain execution stopped with [ErrClientGone].
+	OutcomeClientGone
+)
+
+// String returns lower-case name of the outcome, e.g., "ok", or "panic".
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeOK:
+		return "ok"
+	case OutcomeAbort:
+		return "abort"
+	case OutcomeError:
+		return "error"
+	case OutcomePanic:
+		return "panic"
+	case OutcomeClientGone:
+		return "client_gone"
+	default:
+		return "unknown"
+	}
+}
+
+// Report describes a finished chain execution.
+type Report struct {
+	// Request is the request the chain was executed for.
+	Request *http.Request
+	// Route is the route pattern set via [Route] option, if any.
+	Route string
+	// Status is the response status code. It is [net/http.StatusOK] if nothing was explicitly written to the response, unless the chain panicked, in which case it is [net/http.StatusInternalServerError], as the client gets no response.
+	Status int
+	// Bytes is the number of response body bytes written.
+	Bytes int64
+	// Duration is the time elapsed from the start of the chain execution, until it finished, including the catch callback execution.
+	Duration time.Duration
+	// Step is the 1-based index of the last function in the chain that was executed, i.e., the function that returned an error, or panicked, if the execution did not finish successfully. Functions the execution stopped before, e.g., as the client went away, are not counted, so it is 0 if no function was executed at all.
+	Step int
+	// Outcome describes how the chain execution finished.
+	Outcome Outcome
+	// Err is the error returned by the function at Step, if any.
+	Err error
+	// Panic is the value the function at Step panicked with, if any.
+	Panic any
+}
+
+// Observer is notified after a chain execution finishes. Observers are registered on chains via [Observe] option.
+type Observer interface {
+	Observe(Report)
+}
+
+// ObserverFunc is an adapter to allow the use of ordinary functions as [Observer].
+type ObserverFunc func(Report)
+
+// Observe calls f(report).
+func (f ObserverFunc) Observe(report Report) {
+	f(report)
+}
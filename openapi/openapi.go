@@ -0,0 +1,272 @@
+// Package openapi generates OpenAPI 3.1 documents describing chains registered via a [middle.Router], deriving schemas from types of their functions via reflection.
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/xeptore/middle/v6"
+	"github.com/xeptore/middle/v6/validate"
+)
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components *Components         `json:"components,omitempty"`
+}
+
+// Info is the metadata of the API a [Document] describes.
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem holds operations of a path, by lowercase HTTP method, e.g., "get".
+type PathItem map[string]*Operation
+
+// Operation describes a chain registered for a method, and path.
+type Operation struct {
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter describes a path parameter of an [Operation].
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody describes the request body of an [Operation].
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// MediaType describes content of a request, or response body of a media type.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Response describes a response of an [Operation].
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// Components holds schemas of named struct types referred to by a [Document].
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// middlePkgPath is the import path of the middle package.
+var middlePkgPath = reflect.TypeOf(middle.HTTPError{}).PkgPath()
+
+// Generator generates OpenAPI documents from chains registered via a [middle.Router]. Functions of the chains are recognized by their names reported via [middle.StepInfo], which describes functions wrapped by other functions, e.g., via [middle.Lift2], or [middle.Retry2], by the functions they wrap.
+//
+// For each chain:
+//   - path parameters are derived from the route pattern, and their schemas from result type of [middle.PathParams] function, or [middle.PathParam] function, if the route has a single parameter, and are strings otherwise,
+//   - the request body schema is derived from result type of a function registered via [Generator.MapBody],
+//   - the successful response body schema is derived from result type of the function before the final handler, which is expected to write it, unless it is one of the functions above, a function of the middle package, or a function that returns the previous result unchanged, e.g., one created via [validate.Validate],
+//   - and error responses are derived from errors registered via [Generator.MapErrors] for any of its functions, or functions they are wrapped by.
+type Generator struct {
+	// Info is the metadata of the documents.
+	Info Info
+
+	bodies map[string]string
+	errors map[string][]*middle.HTTPError
+	// pathParam, and pathParams are names of functions created via middle.PathParam, and middle.PathParams respectively.
+	pathParam, pathParams string
+}
+
+// New creates a [Generator] of documents with info as their metadata. Functions created via [middle.DecodeJSON] are registered as request body decoders already, and errors they, [middle.PathParam], [middle.PathParams], [validate.Validate], and [validate.Decode] functions fail with are registered as well.
+func New(info Info) *Generator {
+	g := &Generator{
+		Info:       info,
+		bodies:     make(map[string]string),
+		errors:     make(map[string][]*middle.HTTPError),
+		pathParam:  funcName(middle.PathParam[string]("")),
+		pathParams: funcName(middle.PathParams[struct{}]()),
+	}
+	badRequest := &middle.HTTPError{Status: http.StatusBadRequest, Message: "Invalid path parameters"}
+	g.errors[g.pathParam] = []*middle.HTTPError{badRequest}
+	g.errors[g.pathParams] = []*middle.HTTPError{badRequest}
+	decodeJSON := middle.DecodeJSON[any](middle.JSONOptions{})
+	g.MapBody(decodeJSON, "application/json")
+	g.MapErrors(
+		decodeJSON,
+		&middle.HTTPError{Status: http.StatusBadRequest, Message: "Invalid request body"},
+		&middle.HTTPError{Status: http.StatusRequestEntityTooLarge},
+		&middle.HTTPError{Status: http.StatusUnsupportedMediaType},
+	)
+	invalid := &middle.HTTPError{Status: http.StatusUnprocessableEntity}
+	g.MapErrors(validate.Validate[struct{}](), invalid)
+	g.MapErrors(validate.Decode(decodeJSON), invalid)
+	return g
+}
+
+// MapBody registers fn as a function that decodes the request body of mediaType, e.g., "application/json", into its result. Functions created by the same generic function, e.g., via different instantiations of a decoding function, share the same name, so registering one of them registers all of them.
+func (g *Generator) MapBody(fn any, mediaType string) {
+	g.bodies[funcName(fn)] = mediaType
+}
+
+// MapErrors registers errs as errors fn may fail with. Their statuses, and messages are documented as error responses of chains fn is a function of.
+func (g *Generator) MapErrors(fn any, errs ...*middle.HTTPError) {
+	name := funcName(fn)
+	g.errors[name] = append(g.errors[name], errs...)
+}
+
+// Generate generates a document describing routes. Routes registered for all methods are not described, as OpenAPI operations require a method.
+func (g *Generator) Generate(routes []middle.RouteInfo) *Document {
+	doc := &Document{OpenAPI: "3.1.0", Info: g.Info, Paths: make(map[string]PathItem)}
+	s := newSchemas()
+	for _, route := range routes {
+		if route.Method == "" {
+			continue
+		}
+		path, params := openAPIPath(route.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = make(PathItem)
+			doc.Paths[path] = item
+		}
+		item[strings.ToLower(route.Method)] = g.operation(s, route, params)
+	}
+	if len(s.components) > 0 {
+		doc.Components = &Components{Schemas: s.components}
+	}
+	return doc
+}
+
+// operation describes the chain registered for route, whose path has params.
+func (g *Generator) operation(s *schemas, route middle.RouteInfo, params []string) *Operation {
+	op := &Operation{Responses: make(map[string]Response)}
+	paramTypes := make(map[string]reflect.Type)
+	var single []reflect.Type
+	inputs := make(map[int]bool)
+	for i, step := range route.Steps {
+		for _, name := range append([]string{step.Name}, step.Wrappers...) {
+			for _, err := range g.errors[name] {
+				addError(op, err)
+			}
+		}
+		out, ok := result(step)
+		if !ok {
+			continue
+		}
+		switch step.Name {
+		case g.pathParams:
+			inputs[i] = true
+			for _, field := range reflect.VisibleFields(out) {
+				if name, ok := field.Tag.Lookup("path"); ok && field.IsExported() {
+					paramTypes[name] = field.Type
+				}
+			}
+		case g.pathParam:
+			inputs[i] = true
+			single = append(single, out)
+		}
+		if mediaType, ok := g.bodies[step.Name]; ok {
+			inputs[i] = true
+			op.RequestBody = &RequestBody{Required: true, Content: map[string]MediaType{mediaType: {Schema: s.of(out)}}}
+		}
+	}
+	if len(params) == 1 && len(single) == 1 {
+		paramTypes[params[0]] = single[0]
+	}
+	for _, name := range params {
+		schema := &Schema{Type: "string"}
+		if typ, ok := paramTypes[name]; ok {
+			schema = s.of(typ)
+		}
+		op.Parameters = append(op.Parameters, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+	}
+	success := Response{Description: http.StatusText(http.StatusOK)}
+	if last := len(route.Steps) - 2; last >= 0 && !inputs[last] && !strings.HasPrefix(route.Steps[last].Name, middlePkgPath+".") {
+		if out, isValue := result(route.Steps[last]); isValue && !passThrough(route.Steps[last]) {
+			success.Content = map[string]MediaType{"application/json": {Schema: s.of(out)}}
+		}
+	}
+	op.Responses[strconv.Itoa(http.StatusOK)] = success
+	return op
+}
+
+// addError documents err as an error response of op, joining messages of errors of the same status.
+func addError(op *Operation, err *middle.HTTPError) {
+	status := strconv.Itoa(err.Status)
+	message := err.Message
+	if message == "" {
+		message = http.StatusText(err.Status)
+	}
+	if existing, ok := op.Responses[status]; ok {
+		if !strings.Contains(existing.Description, message) {
+			existing.Description += "; " + message
+			op.Responses[status] = existing
+		}
+		return
+	}
+	op.Responses[status] = Response{Description: message}
+}
+
+// result returns the result type of step, other than the error, and reports whether it has any.
+func result(step middle.StepInfo) (reflect.Type, bool) {
+	if nil == step.Type || step.Type.Kind() != reflect.Func || step.Type.NumOut() != 2 {
+		return nil, false
+	}
+	return step.Type.Out(0), true
+}
+
+// passThrough reports whether step returns the previous result unchanged, e.g., validates it, as its result type is the same as type of its last parameter.
+func passThrough(step middle.StepInfo) bool {
+	in := step.Type.NumIn()
+	return in > 2 && step.Type.In(in-1) == step.Type.Out(0)
+}
+
+// openAPIPath converts path from [net/http.ServeMux] pattern syntax to OpenAPI path template syntax, and returns it with names of its parameters in order.
+func openAPIPath(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, segment := range segments {
+		name, ok := strings.CutPrefix(segment, "{")
+		if !ok {
+			continue
+		}
+		name = strings.TrimSuffix(strings.TrimSuffix(name, "}"), "...")
+		if name == "$" {
+			segments[i] = ""
+			continue
+		}
+		segments[i] = "{" + name + "}"
+		params = append(params, name)
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// Handler returns a [net/http.Handler] that responds with a document describing routes recorded in registry, generated on every request, so that routes registered after the handler is created are described as well.
+func (g *Generator) Handler(registry *middle.Registry) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		response.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(response).Encode(g.Generate(registry.Routes()))
+	})
+}
+
+// funcName returns the fully qualified name of fn, which must be a function, the same way [middle.StepInfo] reports it.
+func funcName(fn any) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+	if f := runtime.FuncForPC(v.Pointer()); nil != f {
+		return f.Name()
+	}
+	return ""
+}
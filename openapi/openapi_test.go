@@ -0,0 +1,116 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/xeptore/middle/v6"
+	"github.com/xeptore/middle/v6/validate"
+)
+
+type body struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type user struct {
+	ID int `json:"id"`
+}
+
+func loadUser(http.ResponseWriter, *http.Request, int) (user, error) {
+	return user{}, nil
+}
+
+func generate(t *testing.T, method, path string, chain middle.Chain) *Operation {
+	t.Helper()
+	r := middle.NewRouter(http.NewServeMux(), nil)
+	r.Handle(method, path, chain)
+	doc := New(Info{Title: "test", Version: "1"}).Generate(r.Routes())
+	op := doc.Paths[path][map[string]string{http.MethodGet: "get", http.MethodPost: "post"}[method]]
+	if nil == op {
+		t.Fatalf("expected %s %s to be described", method, path)
+	}
+	return op
+}
+
+func expectResponses(t *testing.T, op *Operation, statuses ...string) {
+	t.Helper()
+	for _, status := range statuses {
+		if _, ok := op.Responses[status]; !ok {
+			t.Errorf("expected %s response to be documented, got %v", status, op.Responses)
+		}
+	}
+}
+
+func TestValidatedBodyIsNotTheResponse(t *testing.T) {
+	op := generate(t, http.MethodPost, "/users", middle.Chain3(
+		middle.DecodeJSON[body](middle.JSONOptions{}),
+		validate.Validate[body](),
+		func(http.ResponseWriter, *http.Request, body, body) error { return nil },
+	))
+	if nil == op.RequestBody {
+		t.Error("expected request body to be documented")
+	}
+	if content := op.Responses["200"].Content; nil != content {
+		t.Errorf("expected no success response body, got %v", content)
+	}
+	expectResponses(t, op, "400", "413", "415", "422")
+}
+
+func TestLiftedBodyIsDocumented(t *testing.T) {
+	op := generate(t, http.MethodPost, "/users/{id}", middle.Chain3(
+		middle.PathParam[int]("id"),
+		middle.Lift2[int](validate.Decode(middle.DecodeJSON[body](middle.JSONOptions{}))),
+		func(http.ResponseWriter, *http.Request, int, body) error { return nil },
+	))
+	if nil == op.RequestBody || nil == op.RequestBody.Content["application/json"].Schema {
+		t.Fatal("expected JSON request body to be documented")
+	}
+	if content := op.Responses["200"].Content; nil != content {
+		t.Errorf("expected no success response body, got %v", content)
+	}
+	if len(op.Parameters) != 1 || op.Parameters[0].Schema.Type != "integer" {
+		t.Errorf("expected integer id parameter, got %v", op.Parameters)
+	}
+	expectResponses(t, op, "400", "413", "415", "422")
+}
+
+func TestResultOfLastFunctionIsTheResponse(t *testing.T) {
+	op := generate(t, http.MethodGet, "/users/{id}", middle.Chain3(
+		middle.PathParam[int]("id"),
+		middle.Retry2(loadUser, middle.RetryPolicy{}),
+		func(http.ResponseWriter, *http.Request, int, user) error { return nil },
+	))
+	content := op.Responses["200"].Content
+	if nil == content || content["application/json"].Schema.Ref == "" {
+		t.Errorf("expected success response body to refer to user schema, got %v", content)
+	}
+}
+
+type base struct {
+	ID      string `json:"id"`
+	Label   string `json:"Title"`
+	Created string
+}
+
+type audit struct {
+	Title   string
+	Created string
+}
+
+func TestShallowerFieldsHideEmbeddedOnes(t *testing.T) {
+	schema := newSchemas().of(reflect.TypeOf(struct {
+		base
+		audit
+		ID int `json:"id"`
+	}{}))
+	if id := schema.Properties["id"]; nil == id || id.Type != "integer" {
+		t.Errorf("expected integer id, got %v", id)
+	}
+	if _, ok := schema.Properties["Title"]; !ok {
+		t.Errorf("expected tagged Title to win over untagged one, got %v", schema.Properties)
+	}
+	if created, ok := schema.Properties["Created"]; ok {
+		t.Errorf("expected ambiguous Created to be omitted, got %v", created)
+	}
+}
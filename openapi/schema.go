@@ -0,0 +1,224 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Schema is a JSON Schema, as used by OpenAPI 3.1 documents. Only the keywords schemas derived from Go types use are supported.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 any                `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	invalidSchemaNameRe = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+// schemas derives schemas from Go types, collecting named struct types as components.
+type schemas struct {
+	components map[string]*Schema
+	// names holds component names of types that have been visited, so recursive types refer to their components.
+	names map[reflect.Type]string
+}
+
+func newSchemas() *schemas {
+	return &schemas{components: make(map[string]*Schema), names: make(map[reflect.Type]string)}
+}
+
+// of returns the schema of values of type typ, as they are encoded by [encoding/json].
+func (s *schemas) of(typ reflect.Type) *Schema {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	switch {
+	case typ == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case typ.Implements(jsonMarshalerType) || reflect.PointerTo(typ).Implements(jsonMarshalerType):
+		return &Schema{}
+	case typ.Implements(textMarshalerType) || reflect.PointerTo(typ).Implements(textMarshalerType):
+		return &Schema{Type: "string"}
+	}
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		zero := 0.0
+		return &Schema{Type: "integer", Minimum: &zero}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", ContentEncoding: "base64"}
+		}
+		return &Schema{Type: "array", Items: s.of(typ.Elem())}
+	case reflect.Array:
+		return &Schema{Type: "array", Items: s.of(typ.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.of(typ.Elem())}
+	case reflect.Struct:
+		if typ.Name() == "" {
+			return s.object(typ)
+		}
+		return &Schema{Ref: "#/components/schemas/" + s.component(typ)}
+	default:
+		return &Schema{}
+	}
+}
+
+// component registers schema of the named struct type typ as a component, if it is not registered yet, and returns its name.
+func (s *schemas) component(typ reflect.Type) string {
+	if name, ok := s.names[typ]; ok {
+		return name
+	}
+	name := invalidSchemaNameRe.ReplaceAllString(typ.Name(), "_")
+	if _, taken := s.components[name]; taken {
+		name = invalidSchemaNameRe.ReplaceAllString(typ.PkgPath(), "_") + "." + name
+	}
+	s.names[typ] = name
+	s.components[name] = nil
+	s.components[name] = s.object(typ)
+	return name
+}
+
+// object returns the schema of the struct type typ, whose properties are its exported fields, named after their json struct tags. Fields without omitempty option are required.
+func (s *schemas) object(typ reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	s.fields(schema, typ)
+	return schema
+}
+
+// fields adds exported fields of the struct type typ to properties of schema, including fields of untagged embedded structs, the same way [encoding/json] promotes them.
+func (s *schemas) fields(schema *Schema, typ reflect.Type) {
+	for _, f := range visibleFields(typ) {
+		schema.Properties[f.name] = s.of(f.typ)
+		if f.required {
+			schema.Required = append(schema.Required, f.name)
+		}
+	}
+}
+
+// field is a property of an object schema, declared by a struct field at index of the struct type, or of the structs it embeds.
+type field struct {
+	name     string
+	index    []int
+	typ      reflect.Type
+	tagged   bool
+	required bool
+}
+
+// visibleFields returns fields of the struct type typ encoded by [encoding/json], in order of their index, i.e., exported fields, and fields promoted from untagged embedded structs. The same as [encoding/json], a field hides fields of the same name that are embedded deeper, and fields of the same name at the same depth hide each other, unless exactly one of them is tagged.
+func visibleFields(typ reflect.Type) []field {
+	var (
+		fields  []field
+		next    = []field{{typ: typ}}
+		visited = make(map[reflect.Type]bool)
+		taken   = make(map[string]bool)
+	)
+	for len(next) > 0 {
+		current := next
+		next = nil
+		var (
+			names []string
+			level = make(map[string][]field)
+		)
+		for _, parent := range current {
+			if visited[parent.typ] {
+				continue
+			}
+			for i := 0; i < parent.typ.NumField(); i++ {
+				sf := parent.typ.Field(i)
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, options, _ := strings.Cut(tag, ",")
+				index := append(parent.index[:len(parent.index):len(parent.index)], i)
+				if sf.Anonymous && name == "" {
+					embedded := sf.Type
+					if embedded.Kind() == reflect.Pointer {
+						embedded = embedded.Elem()
+					}
+					if embedded.Kind() == reflect.Struct {
+						next = append(next, field{index: index, typ: embedded})
+						continue
+					}
+				}
+				if !sf.IsExported() {
+					continue
+				}
+				f := field{name: name, index: index, typ: sf.Type, tagged: name != "", required: !strings.Contains(options, "omitempty")}
+				if !f.tagged {
+					f.name = sf.Name
+				}
+				if taken[f.name] {
+					continue
+				}
+				if _, ok := level[f.name]; !ok {
+					names = append(names, f.name)
+				}
+				level[f.name] = append(level[f.name], f)
+			}
+		}
+		// Types are only marked visited after the whole level, so that a type embedded more than once at the same depth makes its fields hide each other.
+		for _, parent := range current {
+			visited[parent.typ] = true
+		}
+		for _, name := range names {
+			taken[name] = true
+			if f, ok := dominant(level[name]); ok {
+				fields = append(fields, f)
+			}
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		a, b := fields[i].index, fields[j].index
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return fields
+}
+
+// dominant returns the field that hides other fields of the same name at the same depth, i.e., the only one, or the only tagged one, and reports whether there is any.
+func dominant(fields []field) (field, bool) {
+	if len(fields) == 1 {
+		return fields[0], true
+	}
+	var tagged []field
+	for _, f := range fields {
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return field{}, false
+}
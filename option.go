@@ -0,0 +1,142 @@
+package middle
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Option configures execution of a chain. Options are applied in order via With method of a chain, e.g., [ChainHandler1.With].
+type Option func(*config)
+
+// config holds chain execution options. A nil config executes chains with no option applied.
+type config struct {
+	route        string
+	observers    []Observer
+	buffer       bool
+	bufferLimit  int
+	timeout      time.Duration
+	stepTimeouts map[int]time.Duration
+	// stores holds functions storing results of chain functions in the request context, by 1-based position of the function, set via Store option.
+	stores map[int][]store
+	// adapts holds 1-based positions of functions created via Adapt, which can pass changes made by their middlewares to the rest of the chain.
+	adapts map[int]bool
+	// ignoreClientGone, and catchClientGone are set via IgnoreClientGone, and CatchClientGone options respectively.
+	ignoreClientGone bool
+	catchClientGone  bool
+}
+
+// with returns a new config consisting of c options, and options applied on top of them. It does not modify c, as it can be shared between copies of a chain.
+func (c *config) with(options []Option) *config {
+	next := new(config)
+	if nil != c {
+		*next = *c
+		next.observers = append([]Observer(nil), c.observers...)
+		if nil != c.stepTimeouts {
+			next.stepTimeouts = make(map[int]time.Duration, len(c.stepTimeouts))
+			for step, d := range c.stepTimeouts {
+				next.stepTimeouts[step] = d
+			}
+		}
+		if nil != c.stores {
+			next.stores = make(map[int][]store, len(c.stores))
+			for step, stores := range c.stores {
+				next.stores[step] = append([]store(nil), stores...)
+			}
+		}
+	}
+	for _, option := range options {
+		option(next)
+	}
+	return next
+}
+
+// describe returns options c consists of, in the form they are applied, e.g., "Timeout(5s)", in a fixed order. Observers are described by their types.
+func (c *config) describe() []string {
+	if nil == c {
+		return nil
+	}
+	var options []string
+	if c.route != "" {
+		options = append(options, fmt.Sprintf("Route(%q)", c.route))
+	}
+	for _, observer := range c.observers {
+		options = append(options, fmt.Sprintf("Observe(%T)", observer))
+	}
+	if c.buffer {
+		options = append(options, fmt.Sprintf("Buffer(%d)", c.bufferLimit))
+	}
+	if c.timeout > 0 {
+		options = append(options, fmt.Sprintf("Timeout(%s)", c.timeout))
+	}
+	for _, step := range sortedKeys(c.stepTimeouts) {
+		options = append(options, fmt.Sprintf("StepTimeout(%d, %s)", step, c.stepTimeouts[step]))
+	}
+	for _, step := range sortedKeys(c.stores) {
+		for _, store := range c.stores[step] {
+			options = append(options, fmt.Sprintf("Store(%d, %q)", step, store.key))
+		}
+	}
+	if c.ignoreClientGone {
+		options = append(options, "IgnoreClientGone()")
+	}
+	if c.catchClientGone {
+		options = append(options, "CatchClientGone()")
+	}
+	return options
+}
+
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// observed reports whether the chain has any observer registered via [Observe] option.
+func (c *config) observed() bool {
+	return nil != c && len(c.observers) > 0
+}
+
+// deadlines reports whether the chain has any deadline set via [Timeout], or [StepTimeout] options.
+func (c *config) deadlines() bool {
+	return nil != c && (c.timeout > 0 || len(c.stepTimeouts) > 0)
+}
+
+// Route sets the route pattern the chain is mounted on, e.g., "/users/{id}". It is reported to observers via [Report.Route].
+func Route(pattern string) Option {
+	return func(c *config) {
+		c.route = pattern
+	}
+}
+
+// Observe registers observers that will be notified after every chain execution finishes.
+func Observe(observers ...Observer) Option {
+	return func(c *config) {
+		c.observers = append(c.observers, observers...)
+	}
+}
+
+// Buffer makes the chain buffer the response, so that if a function in the chain returns an error, the partially written response is discarded before the catch callback is called, and it can respond with a clean error response. Once the buffered response body exceeds limit bytes, the buffered response is sent to the client, and the rest of it is streamed as usual. A non-positive limit means no limit. Flushing the response also sends the buffered response, and stops buffering.
+func Buffer(limit int) Option {
+	return func(c *config) {
+		c.buffer = true
+		c.bufferLimit = limit
+	}
+}
+
+// IgnoreClientGone makes the chain execute all of its functions even if the client goes away, i.e., the request context is canceled, which otherwise stops the chain execution with [ErrClientGone].
+func IgnoreClientGone() Option {
+	return func(c *config) {
+		c.ignoreClientGone = true
+	}
+}
+
+// CatchClientGone makes the chain call its catch callback with [ErrClientGone] if the client goes away, which is otherwise ignored, the same way [ErrAbort] is.
+func CatchClientGone() Option {
+	return func(c *config) {
+		c.catchClientGone = true
+	}
+}
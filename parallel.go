@@ -0,0 +1,66 @@
+package middle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"sync"
+)
+
+// PanicError is the error a panic recovered by [Parallel2], and the like, is converted to.
+type PanicError struct {
+	// Value is the value the function panicked with.
+	Value any
+	// Stack is the stack trace of the goroutine that panicked, formatted by [runtime/debug.Stack].
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns Value if it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// parallel runs fns concurrently, passing request with a context that is canceled as soon as any of them fails, and waits for all of them to return. It returns the first non-nil error returned, converting panics to [*PanicError].
+func parallel(request *http.Request, fns ...func(*http.Request) error) error {
+	ctx, cancel := context.WithCancel(request.Context())
+	defer cancel()
+	request = request.WithContext(ctx)
+
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+	)
+	wg.Add(len(fns))
+	for _, fn := range fns {
+		go func(fn func(*http.Request) error) {
+			defer wg.Done()
+			if err := recovered(fn, request); nil != err {
+				once.Do(func() {
+					first = err
+					cancel()
+				})
+			}
+		}(fn)
+	}
+	wg.Wait()
+	return first
+}
+
+// recovered calls fn, converting a panic to [*PanicError].
+func recovered(fn func(*http.Request) error, request *http.Request) (err error) {
+	defer func() {
+		if value := recover(); nil != value {
+			err = &PanicError{Value: value, Stack: debug.Stack()}
+		}
+	}()
+	return fn(request)
+}
@@ -0,0 +1,65 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParallelCancelsSiblingsOnError(t *testing.T) {
+	errFailed := errors.New("failed")
+	started := make(chan struct{})
+	fn := Parallel2(func(_ http.ResponseWriter, request *http.Request) (int, error) {
+		close(started)
+		<-request.Context().Done()
+		return 0, request.Context().Err()
+	}, func(http.ResponseWriter, *http.Request) (string, error) {
+		<-started
+		return "", errFailed
+	})
+	_, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if !errors.Is(err, errFailed) {
+		t.Errorf("expected error to be %v, got %v", errFailed, err)
+	}
+}
+
+func TestParallelRecoversPanics(t *testing.T) {
+	canceled := make(chan bool, 1)
+	started := make(chan struct{})
+	fn := Parallel2(func(_ http.ResponseWriter, request *http.Request) (int, error) {
+		close(started)
+		<-request.Context().Done()
+		canceled <- true
+		return 0, nil
+	}, func(http.ResponseWriter, *http.Request) (string, error) {
+		<-started
+		panic("boom")
+	})
+	_, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected error to be %T, got %v", panicErr, err)
+	}
+	if panicErr.Value != "boom" {
+		t.Errorf("expected panic value to be %q, got %v", "boom", panicErr.Value)
+	}
+	if !<-canceled {
+		t.Error("expected sibling to be canceled")
+	}
+}
+
+func TestParallelReturnsResults(t *testing.T) {
+	fn := Parallel2(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 42, nil
+	}, func(http.ResponseWriter, *http.Request) (string, error) {
+		return "answer", nil
+	})
+	tuple, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if nil != err {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if tuple.V1 != 42 || tuple.V2 != "answer" {
+		t.Errorf("expected results to be 42, and %q, got %v, and %q", "answer", tuple.V1, tuple.V2)
+	}
+}
@@ -0,0 +1,151 @@
+package middle
+
+import (
+	"encoding"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// PathParam creates a function that decodes the path parameter called name, matched by a [net/http.ServeMux] pattern, e.g., "/users/{id}", into a value of type T. T can be a string, boolean, integer, or floating-point number type, or a type whose pointer implements [encoding.TextUnmarshaler], e.g., [time.Time]. The function fails with an [*HTTPError] of [net/http.StatusBadRequest] status if the parameter is missing, or can not be decoded. As [net/http.Request.PathValue] does not tell a missing parameter from an empty one, e.g., an empty remainder matched by a "{path...}" wildcard, empty values are decoded into string types, and are reported as missing for other types. It panics if T is not supported. Chains of the httprouter subpackage make httprouter route parameters available via [net/http.Request.PathValue] as well, so it can be used with them too.
+func PathParam[T any](name string) func(http.ResponseWriter, *http.Request) (T, error) {
+	if !decodable(reflect.TypeOf((*T)(nil)).Elem()) {
+		panic(fmt.Sprintf("middle: path parameter %q can not be decoded into %T", name, *new(T)))
+	}
+	return func(_ http.ResponseWriter, request *http.Request) (t T, err error) {
+		err = decodePathValue(request, name, reflect.ValueOf(&t).Elem())
+		return t, err
+	}
+}
+
+// PathParams creates a function that decodes path parameters into fields of a struct of type T, the same way [PathParam] does. Parameters are mapped to fields via their "path" struct tag, e.g., `path:"id"`, and fields without the tag are left intact. Tagged fields promoted through embedded struct pointers are decoded as well, allocating the pointers if they are nil. It panics if T is not a struct type, or any of its tagged fields is of a type [PathParam] does not support, or is promoted through an embedded pointer to an unexported struct type, which can not be allocated.
+func PathParams[T any]() func(http.ResponseWriter, *http.Request) (T, error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("middle: path parameters can not be decoded into %s, as it is not a struct type", typ))
+	}
+	type field struct {
+		index []int
+		name  string
+	}
+	var fields []field
+	for _, f := range reflect.VisibleFields(typ) {
+		name, ok := f.Tag.Lookup("path")
+		if !ok || name == "-" || !f.IsExported() {
+			continue
+		}
+		if !decodable(f.Type) {
+			panic(fmt.Sprintf("middle: path parameter %q can not be decoded into field %s of %s", name, f.Name, typ))
+		}
+		if embedded, ok := unexportedPointer(typ, f.Index); ok {
+			panic(fmt.Sprintf("middle: path parameter %q can not be decoded into field %s of %s, as it is promoted through embedded pointer %s, which can not be allocated", name, f.Name, typ, embedded))
+		}
+		fields = append(fields, field{index: f.Index, name: name})
+	}
+	return func(_ http.ResponseWriter, request *http.Request) (t T, err error) {
+		v := reflect.ValueOf(&t).Elem()
+		for _, f := range fields {
+			if err := decodePathValue(request, f.name, fieldByIndex(v, f.index)); nil != err {
+				return t, err
+			}
+		}
+		return t, nil
+	}
+}
+
+// unexportedPointer returns name of the first unexported embedded pointer field the field at index of struct type typ is promoted through, and reports whether there is any.
+func unexportedPointer(typ reflect.Type, index []int) (string, bool) {
+	for _, i := range index[:len(index)-1] {
+		f := typ.Field(i)
+		typ = f.Type
+		if typ.Kind() != reflect.Pointer {
+			continue
+		}
+		if !f.IsExported() {
+			return f.Name, true
+		}
+		typ = typ.Elem()
+	}
+	return "", false
+}
+
+// fieldByIndex returns the field of struct v at index, the same way [reflect.Value.FieldByIndex] does, but allocates embedded struct pointers it walks through if they are nil, instead of panicking. v must be addressable.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// decodable reports whether path parameters can be decoded into values of type typ.
+func decodable(typ reflect.Type) bool {
+	if reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// decodePathValue decodes the path parameter called name into dst, which must be addressable, and of a type decodable reports true for.
+func decodePathValue(request *http.Request, name string, dst reflect.Value) error {
+	value := request.PathValue(name)
+	if value == "" && dst.Kind() != reflect.String {
+		return &HTTPError{Status: http.StatusBadRequest, Message: fmt.Sprintf("missing path parameter %q", name)}
+	}
+	if err := decodeText(value, dst); nil != err {
+		return &HTTPError{Status: http.StatusBadRequest, Message: fmt.Sprintf("invalid path parameter %q", name), Err: err}
+	}
+	return nil
+}
+
+// decodeText decodes text into dst, which must be addressable, and of a type decodable reports true for.
+func decodeText(text string, dst reflect.Value) error {
+	if u, ok := dst.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(text))
+	}
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if nil != err {
+			return err
+		}
+		dst.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, dst.Type().Bits())
+		if nil != err {
+			return err
+		}
+		dst.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, dst.Type().Bits())
+		if nil != err {
+			return err
+		}
+		dst.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, dst.Type().Bits())
+		if nil != err {
+			return err
+		}
+		dst.SetFloat(f)
+	}
+	return nil
+}
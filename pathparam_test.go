@@ -0,0 +1,158 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// servePath serves a request for path via a [net/http.ServeMux] that routes pattern to a chain of fn, and returns the decoded value, and the error fn returned.
+func servePath[T any](t *testing.T, pattern, path string, fn func(http.ResponseWriter, *http.Request) (T, error)) (T, error) {
+	t.Helper()
+	var (
+		got    T
+		caught error
+	)
+	mux := http.NewServeMux()
+	mux.Handle(pattern, Chain2(fn, func(_ http.ResponseWriter, _ *http.Request, v T) error {
+		got = v
+		return nil
+	}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		caught = err
+	}))
+	mux.HandleFunc("/", func(http.ResponseWriter, *http.Request) {
+		t.Fatalf("expected %s to match %s", path, pattern)
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	return got, caught
+}
+
+func TestPathParamDecodes(t *testing.T) {
+	if id, err := servePath(t, "/users/{id}", "/users/42", PathParam[int]("id")); nil != err || id != 42 {
+		t.Errorf("expected 42, got %d, and %v", id, err)
+	}
+	if name, err := servePath(t, "/users/{name}", "/users/gopher", PathParam[string]("name")); nil != err || name != "gopher" {
+		t.Errorf("expected %q, got %q, and %v", "gopher", name, err)
+	}
+	if ok, err := servePath(t, "/flags/{on}", "/flags/true", PathParam[bool]("on")); nil != err || !ok {
+		t.Errorf("expected true, got %t, and %v", ok, err)
+	}
+	if ratio, err := servePath(t, "/ratios/{r}", "/ratios/0.5", PathParam[float64]("r")); nil != err || ratio != 0.5 {
+		t.Errorf("expected 0.5, got %f, and %v", ratio, err)
+	}
+	day, err := servePath(t, "/days/{day}", "/days/2024-01-02T00:00:00Z", PathParam[time.Time]("day"))
+	if want := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC); nil != err || !day.Equal(want) {
+		t.Errorf("expected %v, got %v, and %v", want, day, err)
+	}
+}
+
+func TestPathParamAcceptsEmptyStrings(t *testing.T) {
+	if rest, err := servePath(t, "/files/{path...}", "/files/", PathParam[string]("path")); nil != err || rest != "" {
+		t.Errorf("expected empty remainder, got %q, and %v", rest, err)
+	}
+	if rest, err := servePath(t, "/files/{path...}", "/files/a/b", PathParam[string]("path")); nil != err || rest != "a/b" {
+		t.Errorf("expected %q, got %q, and %v", "a/b", rest, err)
+	}
+}
+
+func TestPathParamFailsWithBadRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		path    string
+		fn      func(http.ResponseWriter, *http.Request) (int, error)
+		message string
+	}{
+		{"missing", "/users/{id}", "/users/42", PathParam[int]("user"), `missing path parameter "user"`},
+		{"empty", "/files/{n...}", "/files/", PathParam[int]("n"), `missing path parameter "n"`},
+		{"invalid", "/users/{id}", "/users/me", PathParam[int]("id"), `invalid path parameter "id"`},
+		{"overflow", "/users/{id}", "/users/300", func(response http.ResponseWriter, request *http.Request) (int, error) {
+			v, err := PathParam[int8]("id")(response, request)
+			return int(v), err
+		}, `invalid path parameter "id"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := servePath(t, test.pattern, test.path, test.fn)
+			var httpErr *HTTPError
+			if !errors.As(err, &httpErr) || httpErr.Status != http.StatusBadRequest || httpErr.Message != test.message {
+				t.Errorf("expected %d error %q, got %v", http.StatusBadRequest, test.message, err)
+			}
+		})
+	}
+}
+
+func TestPathParamPanicsOnUnsupportedType(t *testing.T) {
+	defer func() {
+		if nil == recover() {
+			t.Error("expected a panic")
+		}
+	}()
+	PathParam[[]string]("ids")
+}
+
+type PathBase struct {
+	Org string `path:"org"`
+}
+
+type pathInner struct {
+	Team string `path:"team"`
+}
+
+type pathParams struct {
+	*PathBase
+	ID      int    `path:"id"`
+	Name    string `path:"-"`
+	Ignored string
+	hidden  string `path:"id"`
+}
+
+func TestPathParamsDecodesTaggedFields(t *testing.T) {
+	params, err := servePath(t, "/orgs/{org}/users/{id}", "/orgs/acme/users/42", PathParams[pathParams]())
+	if nil != err {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if nil == params.PathBase || params.Org != "acme" || params.ID != 42 || params.Name != "" || params.hidden != "" {
+		t.Errorf("expected org %q, and id 42 only, got %+v", "acme", params)
+	}
+}
+
+func TestPathParamsFailsWithBadRequest(t *testing.T) {
+	_, err := servePath(t, "/orgs/{org}/users/{id}", "/orgs/acme/users/me", PathParams[pathParams]())
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != http.StatusBadRequest || !strings.Contains(httpErr.Message, `"id"`) {
+		t.Errorf("expected %d error for %q, got %v", http.StatusBadRequest, "id", err)
+	}
+}
+
+func TestPathParamsPanicsOnConstruction(t *testing.T) {
+	tests := []struct {
+		name  string
+		new   func()
+		panic string
+	}{
+		{"not a struct", func() { PathParams[int]() }, "not a struct type"},
+		{"unsupported field", func() {
+			PathParams[struct {
+				IDs []int `path:"ids"`
+			}]()
+		}, `"ids" can not be decoded into field IDs`},
+		{"unexported embedded pointer", func() {
+			PathParams[struct{ *pathInner }]()
+		}, "embedded pointer pathInner"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				recovered, _ := recover().(string)
+				if !strings.Contains(recovered, test.panic) {
+					t.Errorf("expected panic containing %q, got %q", test.panic, recovered)
+				}
+			}()
+			test.new()
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPipePassesPreviousResult(t *testing.T) {
+	var got []any
+	handler := Pipe3(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 1, nil
+	}, func(_ http.ResponseWriter, _ *http.Request, a int) (string, error) {
+		got = append(got, a)
+		return "two", nil
+	}, func(_ http.ResponseWriter, _ *http.Request, b string) error {
+		got = append(got, b)
+		return nil
+	}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		t.Errorf("expected catch not to be called, got %v", err)
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if len(got) != 2 || got[0] != 1 || got[1] != "two" {
+		t.Errorf("expected results %v, got %v", []any{1, "two"}, got)
+	}
+}
+
+func TestPipeStopsOnError(t *testing.T) {
+	errFailed := errors.New("failed")
+	for _, tc := range []struct {
+		err    error
+		caught bool
+	}{
+		{err: errFailed, caught: true},
+		{err: ErrAbort, caught: false},
+	} {
+		caught := false
+		handler := Pipe3(func(http.ResponseWriter, *http.Request) (int, error) {
+			return 0, tc.err
+		}, func(http.ResponseWriter, *http.Request, int) (int, error) {
+			t.Error("expected second function not to be called")
+			return 0, nil
+		}, func(http.ResponseWriter, *http.Request, int) error {
+			t.Error("expected third function not to be called")
+			return nil
+		}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+			caught = true
+			if !errors.Is(err, tc.err) {
+				t.Errorf("expected error to be %v, got %v", tc.err, err)
+			}
+		})
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		if caught != tc.caught {
+			t.Errorf("expected catch to be called %t on %v, got %t", tc.caught, tc.err, caught)
+		}
+	}
+}
+
+func TestPipeAppliesOptions(t *testing.T) {
+	handler := Pipe2(func(response http.ResponseWriter, _ *http.Request) (int, error) {
+		_, _ = response.Write([]byte("partial"))
+		return 0, nil
+	}, func(http.ResponseWriter, *http.Request, int) error {
+		return errors.New("failed")
+	}).With(Buffer(0)).Finally(func(response http.ResponseWriter, _ *http.Request, _ error) {
+		response.WriteHeader(http.StatusInternalServerError)
+	})
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if response.Code != http.StatusInternalServerError || response.Body.Len() != 0 {
+		t.Errorf("expected status %d, and empty body, got %d, and %q", http.StatusInternalServerError, response.Code, response.Body)
+	}
+}
@@ -0,0 +1,94 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPrefixMiddlewareStoresValues(t *testing.T) {
+	type user struct{ name string }
+	middleware := Prefix2(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 42, nil
+	}, func(http.ResponseWriter, *http.Request, int) (user, error) {
+		return user{"gopher"}, nil
+	}).Middleware(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		t.Errorf("expected catch not to be called, got %v", err)
+	})
+	called := false
+	handler := middleware(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		called = true
+		if id, ok := Value[int](request); !ok || id != 42 {
+			t.Errorf("expected id 42, got %d", id)
+		}
+		if u, ok := Value[user](request); !ok || u.name != "gopher" {
+			t.Errorf("expected user %q, got %q", "gopher", u.name)
+		}
+		if _, ok := Value[string](request); ok {
+			t.Error("expected no value of a type not returned by the prefix")
+		}
+		response.WriteHeader(http.StatusNoContent)
+	}))
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if !called || response.Code != http.StatusNoContent {
+		t.Errorf("expected next handler to respond with %d, got called %t, and %d", http.StatusNoContent, called, response.Code)
+	}
+}
+
+func TestPrefixMiddlewareCallsCatchOnFailure(t *testing.T) {
+	errFailed := errors.New("failed")
+	var caught error
+	middleware := Prefix1(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, errFailed
+	}).Middleware(func(response http.ResponseWriter, _ *http.Request, err error) {
+		caught = err
+		response.WriteHeader(http.StatusUnauthorized)
+	})
+	handler := middleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("expected next handler not to be called")
+	}))
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if !errors.Is(caught, errFailed) || response.Code != http.StatusUnauthorized {
+		t.Errorf("expected error %v, and status %d, got %v, and %d", errFailed, http.StatusUnauthorized, caught, response.Code)
+	}
+}
+
+func TestPrefixMiddlewareKeepsLastValueOfType(t *testing.T) {
+	middleware := Prefix2(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 1, nil
+	}, func(http.ResponseWriter, *http.Request, int) (int, error) {
+		return 2, nil
+	}).Middleware(nil)
+	handler := middleware(http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+		if v, _ := Value[int](request); v != 2 {
+			t.Errorf("expected result of the last function, got %d", v)
+		}
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
+func TestPrefixThenAppliesPrefixOptions(t *testing.T) {
+	prefix := Prefix1(func(response http.ResponseWriter, _ *http.Request) (int, error) {
+		_, _ = response.Write([]byte("partial"))
+		return 0, nil
+	}).With(Buffer(0))
+	handler := prefix.Then(func(http.ResponseWriter, *http.Request, int) error {
+		return errors.New("failed")
+	}).Finally(func(response http.ResponseWriter, _ *http.Request, _ error) {
+		response.WriteHeader(http.StatusInternalServerError)
+	})
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if response.Code != http.StatusInternalServerError || response.Body.Len() != 0 {
+		t.Errorf("expected buffered response to be discarded, got %d, and %q", response.Code, response.Body)
+	}
+}
+
+func TestValueWithoutMiddleware(t *testing.T) {
+	if _, ok := Value[int](httptest.NewRequest(http.MethodGet, "/", nil)); ok {
+		t.Error("expected no value")
+	}
+}
@@ -0,0 +1,196 @@
+package middle
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+)
+
+// ResponseRecorder wraps a [net/http.ResponseWriter], and records the response status code, number of body bytes written, and whether the response headers were already sent. Chains pass a ResponseRecorder as the response writer to their functions, and catch callbacks, which can be retrieved via [Recorder].
+//
+// A recorder created by a chain configured with [Buffer] option buffers the response, so that it can be discarded via [ResponseRecorder.Reset] before it is sent to the client.
+//
+// It satisfies [net/http.Flusher], [net/http.Hijacker], and [io.ReaderFrom] regardless of the underlying writer, falling back to what [net/http.ResponseController] does if the underlying writer does not support them. It can also be unwrapped by [net/http.ResponseController].
+//
+// Chains reuse recorders across requests, the same way [net/http.Server] reuses response writers, so a recorder must not be used after the chain function, or catch callback it was passed to returns, e.g., by a goroutine that outlives the request.
+type ResponseRecorder struct {
+	writer    http.ResponseWriter
+	status    int
+	bytes     int64
+	buffering bool
+	limit     int
+	buffer    bytes.Buffer
+	header    http.Header
+	// exec is the chain execution the recorder is created for, if any.
+	exec *execution
+}
+
+// NewResponseRecorder returns a [ResponseRecorder] that wraps w.
+func NewResponseRecorder(w http.ResponseWriter) *ResponseRecorder {
+	return &ResponseRecorder{writer: w}
+}
+
+// Recorder returns the [ResponseRecorder] w is, or wraps, unwrapping it the same way [net/http.ResponseController] does. It returns false if there is no such recorder.
+func Recorder(w http.ResponseWriter) (*ResponseRecorder, bool) {
+	for {
+		switch t := w.(type) {
+		case *ResponseRecorder:
+			return t, true
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = t.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+}
+
+// Status returns the response status code, or 0 if headers were not written yet.
+func (rec *ResponseRecorder) Status() int {
+	return rec.status
+}
+
+// BytesWritten returns the number of response body bytes written.
+func (rec *ResponseRecorder) BytesWritten() int64 {
+	return rec.bytes
+}
+
+// Written reports whether the response headers were already written, in which case status code can not be changed anymore, unless the response is buffered, and [ResponseRecorder.Reset] discards it.
+func (rec *ResponseRecorder) Written() bool {
+	return rec.status != 0
+}
+
+// Buffered reports whether the response is being buffered, i.e., nothing written to the recorder is sent to the client yet.
+func (rec *ResponseRecorder) Buffered() bool {
+	return rec.buffering
+}
+
+// Reset discards the buffered response, including the headers set after the recorder started buffering, and reports whether it did. It does nothing, and returns false if the response is not buffered, or the buffer already exceeded its limit, and was sent to the client.
+func (rec *ResponseRecorder) Reset() bool {
+	if !rec.buffering {
+		return false
+	}
+	header := rec.writer.Header()
+	for key := range header {
+		delete(header, key)
+	}
+	for key, values := range rec.header {
+		header[key] = append([]string(nil), values...)
+	}
+	rec.status = 0
+	rec.bytes = 0
+	rec.buffer.Reset()
+	return true
+}
+
+// bufferUpTo makes the recorder buffer the response until its body exceeds limit bytes. A non-positive limit means no limit.
+func (rec *ResponseRecorder) bufferUpTo(limit int) {
+	rec.buffering = true
+	rec.limit = limit
+	rec.header = rec.writer.Header().Clone()
+}
+
+// commit stops buffering, and sends the buffered response to the client.
+func (rec *ResponseRecorder) commit() error {
+	if !rec.buffering {
+		return nil
+	}
+	rec.buffering = false
+	rec.header = nil
+	if !rec.Written() {
+		return nil
+	}
+	rec.writer.WriteHeader(rec.status)
+	defer rec.buffer.Reset()
+	if rec.buffer.Len() == 0 {
+		return nil
+	}
+	_, err := rec.writer.Write(rec.buffer.Bytes())
+	return err
+}
+
+// Header satisfies [net/http.ResponseWriter].
+func (rec *ResponseRecorder) Header() http.Header {
+	return rec.writer.Header()
+}
+
+// WriteHeader satisfies [net/http.ResponseWriter]. Unlike most of [net/http.ResponseWriter] implementations, it silently ignores superfluous calls, i.e., the calls made after the headers were already sent, so catch callbacks can safely call it.
+func (rec *ResponseRecorder) WriteHeader(status int) {
+	if rec.Written() {
+		return
+	}
+	if status >= 100 && status <= 199 && status != http.StatusSwitchingProtocols {
+		rec.writer.WriteHeader(status)
+		return
+	}
+	rec.status = status
+	if !rec.buffering {
+		rec.writer.WriteHeader(status)
+	}
+}
+
+// Write satisfies [net/http.ResponseWriter].
+func (rec *ResponseRecorder) Write(b []byte) (int, error) {
+	rec.implicitWriteHeader()
+	if rec.buffering {
+		n, _ := rec.buffer.Write(b)
+		rec.bytes += int64(n)
+		if rec.limit > 0 && rec.buffer.Len() > rec.limit {
+			return n, rec.commit()
+		}
+		return n, nil
+	}
+	n, err := rec.writer.Write(b)
+	rec.bytes += int64(n)
+	return n, err
+}
+
+// ReadFrom satisfies [io.ReaderFrom]. It uses the underlying writer ReadFrom method if it has one.
+func (rec *ResponseRecorder) ReadFrom(src io.Reader) (int64, error) {
+	rec.implicitWriteHeader()
+	if rec.buffering {
+		return io.Copy(struct{ io.Writer }{rec}, src)
+	}
+	var (
+		n   int64
+		err error
+	)
+	if rf, ok := rec.writer.(io.ReaderFrom); ok {
+		n, err = rf.ReadFrom(src)
+	} else {
+		n, err = io.Copy(struct{ io.Writer }{rec.writer}, src)
+	}
+	rec.bytes += n
+	return n, err
+}
+
+// Flush satisfies [net/http.Flusher]. It is a no-op if the underlying writer does not support flushing.
+func (rec *ResponseRecorder) Flush() {
+	_ = rec.FlushError()
+}
+
+// FlushError flushes buffered data to the client, and is preferred over Flush by [net/http.ResponseController]. If the response is buffered, it stops buffering, and sends the buffered response first. It returns an error wrapping [net/http.ErrNotSupported] if the underlying writer does not support flushing.
+func (rec *ResponseRecorder) FlushError() error {
+	rec.implicitWriteHeader()
+	if err := rec.commit(); nil != err {
+		return err
+	}
+	return http.NewResponseController(rec.writer).Flush()
+}
+
+// Hijack satisfies [net/http.Hijacker]. It returns an error wrapping [net/http.ErrNotSupported] if the underlying writer does not support hijacking.
+func (rec *ResponseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(rec.writer).Hijack()
+}
+
+// Unwrap returns the underlying writer, so it can be used by [net/http.ResponseController].
+func (rec *ResponseRecorder) Unwrap() http.ResponseWriter {
+	return rec.writer
+}
+
+func (rec *ResponseRecorder) implicitWriteHeader() {
+	if !rec.Written() {
+		rec.status = http.StatusOK
+	}
+}
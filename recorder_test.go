@@ -0,0 +1,187 @@
+package middle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBufferResetsResponseBeforeCatch(t *testing.T) {
+	errFailed := errors.New("failed")
+	handler := Chain2(func(response http.ResponseWriter, _ *http.Request) (int, error) {
+		response.Header().Set("X-Partial", "true")
+		response.WriteHeader(http.StatusCreated)
+		_, _ = response.Write([]byte("partial"))
+		return 0, nil
+	}, func(http.ResponseWriter, *http.Request, int) error {
+		return errFailed
+	}).With(Buffer(0)).Finally(func(response http.ResponseWriter, _ *http.Request, err error) {
+		if !errors.Is(err, errFailed) {
+			t.Errorf("expected error to be %v, got %v", errFailed, err)
+		}
+		http.Error(response, "failed", http.StatusInternalServerError)
+	})
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if response.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, response.Code)
+	}
+	if response.Body.String() != "failed\n" {
+		t.Errorf("expected body %q, got %q", "failed\n", response.Body)
+	}
+	if response.Header().Get("X-Partial") != "" {
+		t.Error("expected partial response headers to be discarded")
+	}
+}
+
+func TestBufferSendsResponseOnSuccess(t *testing.T) {
+	handler := Chain1(func(response http.ResponseWriter, _ *http.Request) error {
+		response.WriteHeader(http.StatusCreated)
+		_, _ = response.Write([]byte("created"))
+		return nil
+	}).With(Buffer(0))
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if response.Code != http.StatusCreated || response.Body.String() != "created" {
+		t.Errorf("expected status %d, and body %q, got %d, and %q", http.StatusCreated, "created", response.Code, response.Body)
+	}
+}
+
+func TestResponseRecorderRecordsResponse(t *testing.T) {
+	response := httptest.NewRecorder()
+	rec := NewResponseRecorder(response)
+	if rec.Written() || rec.Status() != 0 || rec.BytesWritten() != 0 {
+		t.Errorf("expected nothing to be recorded, got status %d, and %d bytes", rec.Status(), rec.BytesWritten())
+	}
+	rec.WriteHeader(http.StatusCreated)
+	rec.WriteHeader(http.StatusTeapot)
+	_, _ = rec.Write([]byte("created"))
+	if !rec.Written() || rec.Status() != http.StatusCreated || rec.BytesWritten() != 7 {
+		t.Errorf("expected status %d, and 7 bytes, got %d, and %d", http.StatusCreated, rec.Status(), rec.BytesWritten())
+	}
+	if response.Code != http.StatusCreated || response.Body.String() != "created" {
+		t.Errorf("expected status %d, and body %q, got %d, and %q", http.StatusCreated, "created", response.Code, response.Body)
+	}
+}
+
+func TestResponseRecorderWriteImpliesOK(t *testing.T) {
+	rec := NewResponseRecorder(httptest.NewRecorder())
+	_, _ = rec.Write([]byte("ok"))
+	if rec.Status() != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Status())
+	}
+}
+
+// readerFromWriter is a [net/http.ResponseWriter] that implements [io.ReaderFrom].
+type readerFromWriter struct {
+	*httptest.ResponseRecorder
+	called bool
+}
+
+func (w *readerFromWriter) ReadFrom(src io.Reader) (int64, error) {
+	w.called = true
+	return io.Copy(w.ResponseRecorder, src)
+}
+
+func TestResponseRecorderReadFrom(t *testing.T) {
+	t.Run("unbuffered", func(t *testing.T) {
+		response := &readerFromWriter{ResponseRecorder: httptest.NewRecorder()}
+		rec := NewResponseRecorder(response)
+		n, err := rec.ReadFrom(strings.NewReader("hello"))
+		if nil != err || n != 5 || rec.BytesWritten() != 5 || rec.Status() != http.StatusOK {
+			t.Errorf("expected 5 bytes, and status %d, got %d (%d recorded), status %d, and %v", http.StatusOK, n, rec.BytesWritten(), rec.Status(), err)
+		}
+		if !response.called || response.Body.String() != "hello" {
+			t.Errorf("expected underlying ReadFrom to write %q, got called %t, and %q", "hello", response.called, response.Body)
+		}
+	})
+	t.Run("unbuffered without ReadFrom", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		rec := NewResponseRecorder(response)
+		n, err := rec.ReadFrom(strings.NewReader("hello"))
+		if nil != err || n != 5 || rec.BytesWritten() != 5 || response.Body.String() != "hello" {
+			t.Errorf("expected 5 bytes to be written, got %d (%d recorded), %q, and %v", n, rec.BytesWritten(), response.Body, err)
+		}
+	})
+	t.Run("buffered", func(t *testing.T) {
+		response := &readerFromWriter{ResponseRecorder: httptest.NewRecorder()}
+		rec := NewResponseRecorder(response)
+		rec.bufferUpTo(0)
+		n, err := rec.ReadFrom(strings.NewReader("hello"))
+		if nil != err || n != 5 || rec.BytesWritten() != 5 {
+			t.Errorf("expected 5 bytes, got %d (%d recorded), and %v", n, rec.BytesWritten(), err)
+		}
+		if response.called || response.Body.Len() != 0 {
+			t.Errorf("expected nothing to be sent, got called %t, and %q", response.called, response.Body)
+		}
+	})
+}
+
+func TestResponseRecorderFlushCommitsBuffer(t *testing.T) {
+	response := httptest.NewRecorder()
+	rec := NewResponseRecorder(response)
+	rec.bufferUpTo(0)
+	rec.WriteHeader(http.StatusAccepted)
+	_, _ = rec.Write([]byte("partial"))
+	if response.Body.Len() != 0 {
+		t.Fatalf("expected buffered response not to be sent, got %q", response.Body)
+	}
+	rec.Flush()
+	if rec.Buffered() || rec.Reset() {
+		t.Error("expected recorder to stop buffering")
+	}
+	if response.Code != http.StatusAccepted || response.Body.String() != "partial" || !response.Flushed {
+		t.Errorf("expected status %d, and body %q to be flushed, got %d, %q, and flushed %t", http.StatusAccepted, "partial", response.Code, response.Body, response.Flushed)
+	}
+}
+
+func TestResponseRecorderBufferLimitCommits(t *testing.T) {
+	response := httptest.NewRecorder()
+	rec := NewResponseRecorder(response)
+	rec.bufferUpTo(4)
+	_, _ = rec.Write([]byte("abc"))
+	if response.Body.Len() != 0 {
+		t.Fatalf("expected response within limit not to be sent, got %q", response.Body)
+	}
+	_, _ = rec.Write([]byte("de"))
+	if rec.Buffered() || response.Body.String() != "abcde" {
+		t.Errorf("expected response exceeding limit to be sent, got %q", response.Body)
+	}
+}
+
+// deadlineSetter is a [net/http.ResponseWriter] that records write deadlines set via [net/http.ResponseController].
+type deadlineSetter struct {
+	*httptest.ResponseRecorder
+	deadline time.Time
+}
+
+func (w *deadlineSetter) SetWriteDeadline(deadline time.Time) error {
+	w.deadline = deadline
+	return nil
+}
+
+func TestResponseRecorderUnwrap(t *testing.T) {
+	response := &deadlineSetter{ResponseRecorder: httptest.NewRecorder()}
+	rec := NewResponseRecorder(response)
+	deadline := time.Now().Add(time.Minute)
+	if err := http.NewResponseController(rec).SetWriteDeadline(deadline); nil != err {
+		t.Fatalf("expected response controller to reach underlying writer, got %v", err)
+	}
+	if !response.deadline.Equal(deadline) {
+		t.Errorf("expected deadline %v, got %v", deadline, response.deadline)
+	}
+	if _, _, err := rec.Hijack(); !errors.Is(err, http.ErrNotSupported) {
+		t.Errorf("expected hijack error to be %v, got %v", http.ErrNotSupported, err)
+	}
+	if got, ok := Recorder(&deadlineWriter{rec, context.Background()}); !ok || got != rec {
+		t.Error("expected recorder to be found through wrapping writer")
+	}
+	if _, ok := Recorder(httptest.NewRecorder()); ok {
+		t.Error("expected no recorder to be found")
+	}
+}
@@ -0,0 +1,136 @@
+package middle
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/xeptore/middle/v6/internal/wrap"
+)
+
+// StepInfo describes a function of a chain registered on a [Router].
+type StepInfo struct {
+	// Name is the fully qualified name of the function, as reported by [runtime.FuncForPC], e.g., "github.com/acme/api.authenticate". Functions created by other functions, e.g., [PathParam], are reported by name of the closure, e.g., "github.com/xeptore/middle/v6.PathParam[...].func1". Functions that wrap other functions, i.e., ones created via [Lift2], [Retry2], [Fallback2], and the like, are described by the innermost function they wrap.
+	Name string
+	// Type is the type of the function, whose parameters, and results include results of previous functions it receives, and the function result type respectively.
+	Type reflect.Type
+	// Wrappers are names of functions that wrap the function, from the outermost one, which is the chain function itself, e.g., "github.com/xeptore/middle/v6.Lift2[...].func1". It is empty if the function is not wrapped.
+	Wrappers []string
+}
+
+// MarshalJSON encodes the step with its type formatted as a string, e.g., "func(http.ResponseWriter, *http.Request, string) (int, error)".
+func (s StepInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name     string   `json:"name"`
+		Type     string   `json:"type"`
+		Wrappers []string `json:"wrappers,omitempty"`
+	}{s.Name, s.String(), s.Wrappers})
+}
+
+// String returns the step type formatted as a string.
+func (s StepInfo) String() string {
+	if nil == s.Type {
+		return ""
+	}
+	return s.Type.String()
+}
+
+// stepInfo describes fn, which must be a function, by the innermost function it wraps, if it is a wrapper recorded via the wrap package.
+func stepInfo(fn any) StepInfo {
+	var wrappers []string
+	for {
+		wrapped, ok := wrap.Unwrap(fn)
+		if !ok {
+			break
+		}
+		wrappers = append(wrappers, funcName(fn))
+		fn = wrapped
+	}
+	return StepInfo{Name: funcName(fn), Type: reflect.TypeOf(fn), Wrappers: wrappers}
+}
+
+// funcName returns the fully qualified name of fn, which must be a function, or an empty string if it is nil.
+func funcName(fn any) string {
+	v := reflect.ValueOf(fn)
+	if !v.IsValid() || v.IsNil() {
+		return ""
+	}
+	if f := runtime.FuncForPC(v.Pointer()); nil != f {
+		return f.Name()
+	}
+	return ""
+}
+
+// RouteInfo describes a chain registered on a [Router].
+type RouteInfo struct {
+	// Method is the HTTP method the chain is registered for. It is empty if the chain is registered for all methods.
+	Method string `json:"method"`
+	// Path is the path pattern the chain is registered for, including prefixes of the groups it is registered via, e.g., "/api/users/{id}".
+	Path string `json:"path"`
+	// Pattern is the pattern the chain is registered on the [Mux] with, e.g., "GET /api/users/{id}".
+	Pattern string `json:"pattern"`
+	// Steps are functions of the chain in order of execution.
+	Steps []StepInfo `json:"steps"`
+	// Catch is the fully qualified name of the catch callback the chain is registered with, if there is any.
+	Catch string `json:"catch"`
+	// Options are the options the chain executes with, including the ones applied by the router, in the form they are applied, e.g., "Timeout(5s)". Observers are described by their types.
+	Options []string `json:"options"`
+}
+
+// Registry records chains registered via a [Router], and all of its groups. It satisfies [net/http.Handler] by serving the recorded routes as JSON, or as an HTML page to clients that accept "text/html" responses, e.g., browsers, which is meant for debugging, and should not be exposed publicly.
+type Registry struct {
+	mu     sync.Mutex
+	routes []RouteInfo
+}
+
+// Routes returns the recorded routes in order of registration.
+func (r *Registry) Routes() []RouteInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RouteInfo(nil), r.routes...)
+}
+
+func (r *Registry) add(route RouteInfo) {
+	r.mu.Lock()
+	r.routes = append(r.routes, route)
+	r.mu.Unlock()
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It responds with the recorded routes.
+func (r *Registry) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	routes := r.Routes()
+	if strings.Contains(request.Header.Get("Accept"), "text/html") {
+		response.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = registryTemplate.Execute(response, routes)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(response)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(routes)
+}
+
+var registryTemplate = template.Must(template.New("registry").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Routes</title></head>
+<body>
+<table border="1" cellpadding="4">
+<thead><tr><th>Pattern</th><th>Steps</th><th>Catch</th><th>Options</th></tr></thead>
+<tbody>
+{{- range .}}
+<tr>
+<td><code>{{.Pattern}}</code></td>
+<td><ol>{{range .Steps}}<li><code>{{.Name}}</code><br><code>{{.}}</code>{{range .Wrappers}}<br>via <code>{{.}}</code>{{end}}</li>{{end}}</ol></td>
+<td><code>{{.Catch}}</code></td>
+<td>{{range .Options}}<code>{{.}}</code><br>{{end}}</td>
+</tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
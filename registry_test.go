@@ -0,0 +1,31 @@
+package middle
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func describedStep(http.ResponseWriter, *http.Request) (int, error) {
+	return 0, nil
+}
+
+func TestStepInfoDescribesWrappedFunction(t *testing.T) {
+	step := stepInfo(Lift2[string](Retry1(describedStep, RetryPolicy{})))
+	if !strings.HasSuffix(step.Name, ".describedStep") {
+		t.Errorf("expected step to be described by the wrapped function, got %q", step.Name)
+	}
+	if step.String() != "func(http.ResponseWriter, *http.Request) (int, error)" {
+		t.Errorf("expected step to have type of the wrapped function, got %s", step)
+	}
+	if len(step.Wrappers) != 2 || !strings.Contains(step.Wrappers[0], ".Lift2[") || !strings.Contains(step.Wrappers[1], ".Retry1[") {
+		t.Errorf("expected Lift2, and Retry1 wrappers, got %v", step.Wrappers)
+	}
+}
+
+func TestStepInfoDescribesPlainFunction(t *testing.T) {
+	step := stepInfo(describedStep)
+	if !strings.HasSuffix(step.Name, ".describedStep") || len(step.Wrappers) != 0 {
+		t.Errorf("expected step to be described as is, got %q, and %v", step.Name, step.Wrappers)
+	}
+}
@@ -0,0 +1,93 @@
+package middle
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// Clock waits for durations. It is used by [RetryPolicy] to wait between attempts, and can be replaced in tests to not actually wait.
+type Clock interface {
+	// After waits for d to elapse, and then sends the current time on the returned channel, the same way [time.After] does.
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// RetryPolicy describes how functions created via [Retry1], and the like, retry the functions they wrap. Its zero value retries up to 3 attempts with exponential backoff starting from 100 milliseconds.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one. Defaults to 3 if it is non-positive.
+	MaxAttempts int
+	// BaseDelay is the delay before the second attempt. Each subsequent delay is multiplied by Multiplier. Defaults to 100 milliseconds if it is non-positive.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts. Delays are not capped if it is non-positive.
+	MaxDelay time.Duration
+	// Multiplier is the factor delays grow by after each attempt. Defaults to 2 if it is less than 1.
+	Multiplier float64
+	// Jitter is the fraction of each delay that is randomized, between 0 and 1, e.g., 0.2 makes a delay of 100 milliseconds fall randomly between 80, and 100 milliseconds. No jitter is applied if it is 0.
+	Jitter float64
+	// Retryable reports whether an attempt that failed with err should be retried. If it is nil, all errors are retried, except [ErrAbort], [context.Canceled], [context.DeadlineExceeded], and [*HTTPError] of a status code below 500, as retrying invalid requests does not make them succeed.
+	Retryable func(err error) bool
+	// Clock is used to wait between attempts. Defaults to the system clock.
+	Clock Clock
+	// Rand returns a pseudo-random number in [0, 1) used to apply jitter. Defaults to [math/rand.Float64].
+	Rand func() float64
+}
+
+// Do calls fn until it succeeds, returns an error that is not retryable, or the maximum number of attempts is reached, waiting between attempts according to the policy. It stops waiting if ctx is done. It returns the error returned by the last attempt.
+func (p RetryPolicy) Do(ctx context.Context, fn func() error) error {
+	maxAttempts, delay, multiplier, retryable, clock, random := p.MaxAttempts, p.BaseDelay, p.Multiplier, p.Retryable, p.Clock, p.Rand
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	if delay <= 0 {
+		delay = 100 * time.Millisecond
+	}
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	if nil == retryable {
+		retryable = retryableByDefault
+	}
+	if nil == clock {
+		clock = systemClock{}
+	}
+	if nil == random {
+		random = rand.Float64
+	}
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if nil == err || attempt >= maxAttempts || !retryable(err) {
+			return err
+		}
+		if p.MaxDelay > 0 && delay > p.MaxDelay {
+			delay = p.MaxDelay
+		}
+		wait := delay
+		if p.Jitter > 0 {
+			wait -= time.Duration(p.Jitter * random() * float64(delay))
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-clock.After(wait):
+		}
+		delay = time.Duration(float64(delay) * multiplier)
+	}
+}
+
+func retryableByDefault(err error) bool {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.Status < http.StatusInternalServerError {
+		return false
+	}
+	return !errors.Is(err, ErrAbort) &&
+		!errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,120 @@
+package middle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fakeClock records durations it is asked to wait for, and fires immediately.
+type fakeClock struct {
+	waits []time.Duration
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	clock := &fakeClock{}
+	policy := RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   time.Second,
+		MaxDelay:    5 * time.Second,
+		Multiplier:  3,
+		Clock:       clock,
+	}
+	errFailed := errors.New("failed")
+	attempts := 0
+	err := policy.Do(context.Background(), func() error {
+		attempts++
+		return errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Errorf("expected error to be %v, got %v", errFailed, err)
+	}
+	if attempts != 5 {
+		t.Errorf("expected 5 attempts, got %d", attempts)
+	}
+	expected := []time.Duration{time.Second, 3 * time.Second, 5 * time.Second, 5 * time.Second}
+	if len(clock.waits) != len(expected) {
+		t.Fatalf("expected waits %v, got %v", expected, clock.waits)
+	}
+	for i := range expected {
+		if clock.waits[i] != expected[i] {
+			t.Errorf("expected waits %v, got %v", expected, clock.waits)
+			break
+		}
+	}
+}
+
+func TestRetryPolicyJitter(t *testing.T) {
+	clock := &fakeClock{}
+	policy := RetryPolicy{
+		MaxAttempts: 2,
+		BaseDelay:   time.Second,
+		Jitter:      0.5,
+		Clock:       clock,
+		Rand:        func() float64 { return 0.5 },
+	}
+	_ = policy.Do(context.Background(), func() error { return errors.New("failed") })
+	if len(clock.waits) != 1 || clock.waits[0] != 750*time.Millisecond {
+		t.Errorf("expected waits [750ms], got %v", clock.waits)
+	}
+}
+
+func TestRetryPolicyStopsOnSuccess(t *testing.T) {
+	clock := &fakeClock{}
+	attempts := 0
+	err := RetryPolicy{Clock: clock}.Do(context.Background(), func() error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	if nil != err {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if attempts != 2 || len(clock.waits) != 1 {
+		t.Errorf("expected 2 attempts, and 1 wait, got %d attempts, and waits %v", attempts, clock.waits)
+	}
+}
+
+func TestRetryPolicyDoesNotRetryClientErrors(t *testing.T) {
+	clock := &fakeClock{}
+	attempts := 0
+	err := RetryPolicy{Clock: clock}.Do(context.Background(), func() error {
+		attempts++
+		return &HTTPError{Status: http.StatusBadRequest}
+	})
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Errorf("expected error to be %T, got %v", httpErr, err)
+	}
+	if attempts != 1 || len(clock.waits) != 0 {
+		t.Errorf("expected 1 attempt, and no waits, got %d attempts, and waits %v", attempts, clock.waits)
+	}
+}
+
+func TestRetryStopsWaitingOnceRequestContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	attempts := 0
+	fn := Retry1(func(http.ResponseWriter, *http.Request) (int, error) {
+		attempts++
+		return 0, errors.New("failed")
+	}, RetryPolicy{BaseDelay: time.Hour})
+	if _, err := fn(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)); nil == err {
+		t.Error("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
@@ -0,0 +1,94 @@
+package middle
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Mux registers handlers for route patterns. [*net/http.ServeMux] satisfies it, and the httprouter subpackage provides an adapter for [github.com/julienschmidt/httprouter.Router]. Patterns are in [net/http.ServeMux] syntax, e.g., "GET /users/{id}".
+type Mux interface {
+	Handle(pattern string, handler http.Handler)
+}
+
+// Chain is a chain that can be registered on a [Router], i.e., [ChainHandler1], [PipeHandler2], [StateHandler], [BuilderHandler], and the like.
+type Chain interface {
+	http.Handler
+	// mount returns a handler that executes the chain with options applied on top of the options it already has, calling catch if any of its functions fails.
+	mount(catch func(http.ResponseWriter, *http.Request, error), options []Option) http.Handler
+	// steps describes functions of the chain in order of execution.
+	steps() []StepInfo
+	// configuration returns options the chain executes with.
+	configuration() *config
+}
+
+// Router registers chains on a [Mux], applying the same catch callback, and options to all of them, so that none of them is registered without a catch callback by mistake. Each chain is registered with [Route] option set to its pattern, and options of the router applied on top of the options it already has. Chains sharing a path prefix, or options can be registered via a group created by [Router.Group].
+type Router struct {
+	mux      Mux
+	prefix   string
+	catch    func(http.ResponseWriter, *http.Request, error)
+	options  []Option
+	registry *Registry
+}
+
+// NewRouter creates a [Router] that registers chains on mux, with catch as their catch callback, and options applied to them, e.g., [Observe] to register the same observers on all of them.
+func NewRouter(mux Mux, catch func(http.ResponseWriter, *http.Request, error), options ...Option) *Router {
+	return &Router{mux: mux, catch: catch, options: options, registry: new(Registry)}
+}
+
+// Group creates a [Router] that registers chains under prefix, relative to the router prefix, with options applied on top of the router options. Chains registered via the group are listed by [Router.Routes] of the router as well.
+func (r *Router) Group(prefix string, options ...Option) *Router {
+	return &Router{
+		mux:      r.mux,
+		prefix:   r.prefix + strings.TrimSuffix(prefix, "/"),
+		catch:    r.catch,
+		options:  append(append([]Option(nil), r.options...), options...),
+		registry: r.registry,
+	}
+}
+
+// Handle registers chain for requests of method to path, relative to the router prefix. An empty method registers the chain for all methods.
+func (r *Router) Handle(method, path string, chain Chain) {
+	info := RouteInfo{Method: method, Path: r.prefix + path, Pattern: r.prefix + path}
+	if method != "" {
+		info.Pattern = method + " " + info.Path
+	}
+	options := append(append([]Option(nil), r.options...), Route(info.Pattern))
+	r.mux.Handle(info.Pattern, chain.mount(r.catch, options))
+	info.Steps, info.Catch, info.Options = chain.steps(), funcName(r.catch), chain.configuration().with(options).describe()
+	r.registry.add(info)
+}
+
+// Get registers chain for GET requests to path, the same way [Router.Handle] does.
+func (r *Router) Get(path string, chain Chain) {
+	r.Handle(http.MethodGet, path, chain)
+}
+
+// Post registers chain for POST requests to path, the same way [Router.Handle] does.
+func (r *Router) Post(path string, chain Chain) {
+	r.Handle(http.MethodPost, path, chain)
+}
+
+// Put registers chain for PUT requests to path, the same way [Router.Handle] does.
+func (r *Router) Put(path string, chain Chain) {
+	r.Handle(http.MethodPut, path, chain)
+}
+
+// Patch registers chain for PATCH requests to path, the same way [Router.Handle] does.
+func (r *Router) Patch(path string, chain Chain) {
+	r.Handle(http.MethodPatch, path, chain)
+}
+
+// Delete registers chain for DELETE requests to path, the same way [Router.Handle] does.
+func (r *Router) Delete(path string, chain Chain) {
+	r.Handle(http.MethodDelete, path, chain)
+}
+
+// Routes returns chains registered via the router, its parent router, if it is a group, and all of their groups, in order of registration.
+func (r *Router) Routes() []RouteInfo {
+	return r.registry.Routes()
+}
+
+// Registry returns the [Registry] chains registered via the router, its parent router, if it is a group, and all of their groups are recorded in. It can be mounted as a debugging endpoint.
+func (r *Router) Registry() *Registry {
+	return r.registry
+}
@@ -0,0 +1,109 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRouterJoinsGroupPrefixes(t *testing.T) {
+	mux := http.NewServeMux()
+	r := NewRouter(mux, nil)
+	api := r.Group("/api/")
+	users := api.Group("/users")
+	var served []string
+	handler := func(name string) ChainHandler1 {
+		return Chain1(func(http.ResponseWriter, *http.Request) error {
+			served = append(served, name)
+			return nil
+		})
+	}
+	r.Get("/health", handler("health"))
+	users.Get("/{id}", handler("user"))
+	users.Handle("", "/", handler("users"))
+	for _, path := range []string{"/health", "/api/users/42", "/api/users/"} {
+		mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	if want := []string{"health", "user", "users"}; !reflect.DeepEqual(served, want) {
+		t.Errorf("expected %v to be served, got %v", want, served)
+	}
+	var patterns []string
+	for _, route := range r.Routes() {
+		patterns = append(patterns, route.Pattern)
+	}
+	if want := []string{"GET /health", "GET /api/users/{id}", "/api/users/"}; !reflect.DeepEqual(patterns, want) {
+		t.Errorf("expected patterns %v, got %v", want, patterns)
+	}
+	if routes := users.Routes(); len(routes) != 3 {
+		t.Errorf("expected groups to share routes of their router, got %d routes", len(routes))
+	}
+}
+
+func TestRouterRecordsRoutes(t *testing.T) {
+	r := NewRouter(http.NewServeMux(), func(http.ResponseWriter, *http.Request, error) {})
+	r.Post("/users", Chain2(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, nil
+	}, func(http.ResponseWriter, *http.Request, int) error {
+		return nil
+	}))
+	routes := r.Routes()
+	if len(routes) != 1 {
+		t.Fatalf("expected 1 route, got %d", len(routes))
+	}
+	route := routes[0]
+	if route.Method != http.MethodPost || route.Path != "/users" || route.Pattern != "POST /users" {
+		t.Errorf("expected POST /users, got %q, %q, and %q", route.Method, route.Path, route.Pattern)
+	}
+	if len(route.Steps) != 2 || route.Steps[1].String() != "func(http.ResponseWriter, *http.Request, int) error" {
+		t.Errorf("expected 2 steps, got %v", route.Steps)
+	}
+	if route.Catch == "" {
+		t.Error("expected catch to be described")
+	}
+	routes[0].Path = "/changed"
+	if r.Routes()[0].Path != "/users" {
+		t.Error("expected routes to be copied")
+	}
+}
+
+func TestRouterAppliesOptionsInOrder(t *testing.T) {
+	var reports []Report
+	observer := ObserverFunc(func(report Report) {
+		reports = append(reports, report)
+	})
+	mux := http.NewServeMux()
+	r := NewRouter(mux, nil, Timeout(time.Hour), Observe(observer))
+	group := r.Group("/api", Timeout(time.Minute))
+	group.Get("/items", Chain1(func(http.ResponseWriter, *http.Request) error {
+		return nil
+	}).With(Buffer(0), Route("/ignored")))
+	want := []string{`Route("GET /api/items")`, "Observe(middle.ObserverFunc)", "Buffer(0)", "Timeout(1m0s)"}
+	if got := r.Routes()[0].Options; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected options %v, got %v", want, got)
+	}
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/items", nil))
+	if len(reports) != 1 || reports[0].Route != "GET /api/items" {
+		t.Errorf("expected 1 report for route %q, got %v", "GET /api/items", reports)
+	}
+}
+
+func TestRouterAppliesCatch(t *testing.T) {
+	errFailed := errors.New("failed")
+	var caught error
+	mux := http.NewServeMux()
+	r := NewRouter(mux, func(response http.ResponseWriter, _ *http.Request, err error) {
+		caught = err
+		response.WriteHeader(http.StatusInternalServerError)
+	})
+	r.Group("/api").Delete("/items/{id}", Chain1(func(http.ResponseWriter, *http.Request) error {
+		return errFailed
+	}))
+	response := httptest.NewRecorder()
+	mux.ServeHTTP(response, httptest.NewRequest(http.MethodDelete, "/api/items/1", nil))
+	if !errors.Is(caught, errFailed) || response.Code != http.StatusInternalServerError {
+		t.Errorf("expected error %v, and status %d, got %v, and %d", errFailed, http.StatusInternalServerError, caught, response.Code)
+	}
+}
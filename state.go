@@ -0,0 +1,82 @@
+package middle
+
+import (
+	"net/http"
+	"sync"
+)
+
+// StateHandler provides capability of processing functions in order, sharing a request state of type S between them, by satisfying [net/http.Handler], or with an optional chain error handler via [StateHandler.Finally] by satisfying [net/http.HandlerFunc]. Unlike [ChainHandler2], and the like, functions do not receive results of previous function calls, but populate fields of the state instead, which makes their signatures independent of their position in the chain.
+//
+// The state is allocated from a [sync.Pool] per request, and is reset, and returned to the pool after the chain execution finishes, so functions must not retain it, or any reference to it, afterward. If *S has a Reset method, it is used to reset the state, otherwise it is set to its zero value.
+type StateHandler[S any] struct {
+	fns    []func(http.ResponseWriter, *http.Request, *S) error
+	pool   *sync.Pool
+	config *config
+}
+
+// State creates a chain of functions sharing a request state of type S that will be executed in order.
+func State[S any](fns ...func(http.ResponseWriter, *http.Request, *S) error) StateHandler[S] {
+	chain := StateHandler[S]{
+		fns:  fns,
+		pool: &sync.Pool{New: func() any { return new(S) }},
+	}
+	chain.config = chain.config.check(chain.steps())
+	return chain
+}
+
+// ServeHTTP satisfies [net/http.Handler]. It executes functions in the chain in order, passing the request state to each of them. If any of the functions in the chain returns a non-nil error, the execution stops.
+func (chain StateHandler[S]) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	chain.serve(response, request, nil)
+}
+
+// Finally executes functions registered via [State] in order, passing the request state to each of them. If any of the functions in the chain returns a non-nil error, the execution stops, and executes catch with that error. If the error is [ErrAbort] according to [errors.Is] semantics, it is ignored, and catch will not be called, although the chain execution stops. The response writer passed to catch is a [*ResponseRecorder], so it can check whether the response was already written.
+func (chain StateHandler[S]) Finally(catch func(http.ResponseWriter, *http.Request, error)) http.HandlerFunc {
+	return func(response http.ResponseWriter, request *http.Request) {
+		chain.serve(response, request, catch)
+	}
+}
+
+// With returns a copy of the chain that executes with options applied on top of the options it already has.
+func (chain StateHandler[S]) With(options ...Option) StateHandler[S] {
+	chain.config = chain.config.with(options).check(chain.steps())
+	return chain
+}
+
+func (chain StateHandler[S]) mount(catch func(http.ResponseWriter, *http.Request, error), options []Option) http.Handler {
+	return chain.With(options...).Finally(catch)
+}
+
+func (chain StateHandler[S]) steps() []StepInfo {
+	steps := make([]StepInfo, len(chain.fns))
+	for i, fn := range chain.fns {
+		steps[i] = stepInfo(fn)
+	}
+	return steps
+}
+
+func (chain StateHandler[S]) configuration() *config {
+	return chain.config
+}
+
+func (chain StateHandler[S]) serve(response http.ResponseWriter, request *http.Request, catch func(http.ResponseWriter, *http.Request, error)) {
+	exec := chain.config.execute(response, request)
+	defer exec.finish(catch)
+	state := chain.pool.Get().(*S)
+	defer chain.release(state)
+	for _, fn := range chain.fns {
+		if !exec.next() || !exec.done(fn(exec.response, exec.request, state)) {
+			return
+		}
+	}
+}
+
+// release resets state, and returns it to the pool.
+func (chain StateHandler[S]) release(state *S) {
+	if resetter, ok := any(state).(interface{ Reset() }); ok {
+		resetter.Reset()
+	} else {
+		var zero S
+		*state = zero
+	}
+	chain.pool.Put(state)
+}
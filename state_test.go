@@ -0,0 +1,92 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type plainState struct {
+	ID    int
+	Names []string
+}
+
+type resettableState struct {
+	ID     int
+	Names  []string
+	resets int
+}
+
+func (s *resettableState) Reset() {
+	s.ID = 0
+	s.Names = s.Names[:0]
+	s.resets++
+}
+
+func TestStateSharesStateBetweenFunctions(t *testing.T) {
+	handler := State(func(_ http.ResponseWriter, _ *http.Request, s *plainState) error {
+		s.ID = 42
+		return nil
+	}, func(response http.ResponseWriter, _ *http.Request, s *plainState) error {
+		if s.ID != 42 {
+			t.Errorf("expected state to be shared, got id %d", s.ID)
+		}
+		response.WriteHeader(http.StatusNoContent)
+		return nil
+	})
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if response.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, response.Code)
+	}
+}
+
+func TestStateStartsFromResetState(t *testing.T) {
+	handler := State(func(_ http.ResponseWriter, _ *http.Request, s *plainState) error {
+		if s.ID != 0 || nil != s.Names {
+			t.Errorf("expected zero state, got %+v", *s)
+		}
+		s.ID = 42
+		s.Names = append(s.Names, "name")
+		return nil
+	})
+	for i := 0; i < 10; i++ {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	}
+}
+
+func TestStateReleaseSetsZeroValue(t *testing.T) {
+	chain := State[plainState]()
+	state := &plainState{ID: 42, Names: []string{"name"}}
+	chain.release(state)
+	if state.ID != 0 || nil != state.Names {
+		t.Errorf("expected zero state, got %+v", *state)
+	}
+}
+
+func TestStateReleaseCallsReset(t *testing.T) {
+	chain := State[resettableState]()
+	state := &resettableState{ID: 42, Names: make([]string, 1, 8)}
+	chain.release(state)
+	if state.resets != 1 || state.ID != 0 || len(state.Names) != 0 || cap(state.Names) != 8 {
+		t.Errorf("expected state to be reset via Reset, got %+v", *state)
+	}
+}
+
+func TestStateStopsOnError(t *testing.T) {
+	errFailed := errors.New("failed")
+	var caught error
+	handler := State(func(http.ResponseWriter, *http.Request, *plainState) error {
+		return errFailed
+	}, func(http.ResponseWriter, *http.Request, *plainState) error {
+		t.Error("expected second function not to be called")
+		return nil
+	}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		caught = err
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if !errors.Is(caught, errFailed) {
+		t.Errorf("expected error to be %v, got %v", errFailed, caught)
+	}
+}
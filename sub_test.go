@@ -0,0 +1,78 @@
+package middle
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSubPassesResultsInOrder(t *testing.T) {
+	var got []any
+	sub := Sub3(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 1, nil
+	}, func(_ http.ResponseWriter, _ *http.Request, a int) (string, error) {
+		got = append(got, a)
+		return "two", nil
+	}, func(_ http.ResponseWriter, _ *http.Request, a int, b string) (bool, error) {
+		got = append(got, a, b)
+		return true, nil
+	})
+	handler := Chain2(sub, func(_ http.ResponseWriter, _ *http.Request, c bool) error {
+		got = append(got, c)
+		return nil
+	}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		t.Errorf("expected catch not to be called, got %v", err)
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	want := []any{1, 1, "two", true}
+	if len(got) != len(want) {
+		t.Fatalf("expected results %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("expected result %d to be %v, got %v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestSubStopsOnError(t *testing.T) {
+	errFailed := errors.New("failed")
+	for _, want := range []error{errFailed, ErrAbort} {
+		called := false
+		sub := Sub3(func(http.ResponseWriter, *http.Request) (int, error) {
+			return 0, want
+		}, func(http.ResponseWriter, *http.Request, int) (int, error) {
+			called = true
+			return 0, nil
+		}, func(http.ResponseWriter, *http.Request, int, int) (int, error) {
+			called = true
+			return 0, nil
+		})
+		if _, err := sub(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil)); err != want {
+			t.Errorf("expected error to be returned as is, got %v, want %v", err, want)
+		}
+		if called {
+			t.Errorf("expected functions after failed one not to be called on %v", want)
+		}
+	}
+}
+
+func TestSubErrorReachesCatch(t *testing.T) {
+	errFailed := errors.New("failed")
+	var caught error
+	handler := Chain2(Sub2(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 1, nil
+	}, func(http.ResponseWriter, *http.Request, int) (int, error) {
+		return 0, errFailed
+	}), func(http.ResponseWriter, *http.Request, int) error {
+		t.Error("expected function after sub-chain not to be called")
+		return nil
+	}).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		caught = err
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if !errors.Is(caught, errFailed) {
+		t.Errorf("expected error to be %v, got %v", errFailed, caught)
+	}
+}
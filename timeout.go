@@ -0,0 +1,109 @@
+package middle
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// ErrTimeout is matched, according to [errors.Is] semantics, by errors chains pass to catch callbacks when they run out of time set via [Timeout], or [StepTimeout] options.
+var ErrTimeout = errors.New("chain execution timed out")
+
+// TimeoutError is the error chains pass to catch callbacks when they run out of time set via [Timeout], or [StepTimeout] options. It matches both [ErrTimeout], and [context.DeadlineExceeded] according to [errors.Is] semantics.
+type TimeoutError struct {
+	// Step is the 1-based index of the function in the chain that was running, or was about to run when the deadline passed.
+	Step int
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("chain execution timed out at step %d", e.Step)
+}
+
+// Is reports whether target is [ErrTimeout].
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+// Unwrap returns [context.DeadlineExceeded].
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// Timeout bounds the chain execution to d. Functions in the chain receive a request with a context that has the deadline set, and once the deadline passes, the chain stops before executing the next function, and calls the catch callback with a [*TimeoutError]. Anything the function that was running when the deadline passed writes to the response afterward is discarded, and the writes return [net/http.ErrHandlerTimeout].
+func Timeout(d time.Duration) Option {
+	return func(c *config) {
+		c.timeout = d
+	}
+}
+
+// StepTimeout bounds execution of the step-th (1-based) function in the chain to d, the same way [Timeout] bounds the whole chain execution. If both are set, whichever deadline comes first applies.
+func StepTimeout(step int, d time.Duration) Option {
+	return func(c *config) {
+		if nil == c.stepTimeouts {
+			c.stepTimeouts = make(map[int]time.Duration)
+		}
+		c.stepTimeouts[step] = d
+	}
+}
+
+// deadlineWriter discards writes made after its context deadline passes, so functions in a chain that ran out of time can not write to the response the catch callback is going to write to, nor flush, or hijack it. Only the deadline passing counts, as writes made after the client went away are harmless.
+type deadlineWriter struct {
+	http.ResponseWriter
+	ctx context.Context
+}
+
+func (w *deadlineWriter) expired() bool {
+	return errors.Is(w.ctx.Err(), context.DeadlineExceeded)
+}
+
+func (w *deadlineWriter) WriteHeader(status int) {
+	if w.expired() {
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *deadlineWriter) Write(b []byte) (int, error) {
+	if w.expired() {
+		return 0, http.ErrHandlerTimeout
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *deadlineWriter) ReadFrom(src io.Reader) (int64, error) {
+	if w.expired() {
+		return 0, http.ErrHandlerTimeout
+	}
+	if rf, ok := w.ResponseWriter.(io.ReaderFrom); ok {
+		return rf.ReadFrom(src)
+	}
+	return io.Copy(struct{ io.Writer }{w.ResponseWriter}, src)
+}
+
+func (w *deadlineWriter) Flush() {
+	_ = w.FlushError()
+}
+
+func (w *deadlineWriter) FlushError() error {
+	if w.expired() {
+		return http.ErrHandlerTimeout
+	}
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *deadlineWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if w.expired() {
+		return nil, nil, http.ErrHandlerTimeout
+	}
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+// Unwrap returns the underlying writer, so it can be used by [net/http.ResponseController], and [Recorder].
+func (w *deadlineWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,97 @@
+package middle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeadlineWriterDiscardsWritesAfterDeadline(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	response := httptest.NewRecorder()
+	w := &deadlineWriter{NewResponseRecorder(response), ctx}
+
+	w.WriteHeader(http.StatusTeapot)
+	if _, err := w.Write([]byte("late")); !errors.Is(err, http.ErrHandlerTimeout) {
+		t.Errorf("expected write error to be %v, got %v", http.ErrHandlerTimeout, err)
+	}
+	if err := w.FlushError(); !errors.Is(err, http.ErrHandlerTimeout) {
+		t.Errorf("expected flush error to be %v, got %v", http.ErrHandlerTimeout, err)
+	}
+	if err := http.NewResponseController(w).Flush(); !errors.Is(err, http.ErrHandlerTimeout) {
+		t.Errorf("expected response controller flush error to be %v, got %v", http.ErrHandlerTimeout, err)
+	}
+	if _, _, err := w.Hijack(); !errors.Is(err, http.ErrHandlerTimeout) {
+		t.Errorf("expected hijack error to be %v, got %v", http.ErrHandlerTimeout, err)
+	}
+	if response.Flushed || response.Code != http.StatusOK || response.Body.Len() != 0 {
+		t.Errorf("expected response to be untouched, got status %d, body %q, flushed %t", response.Code, response.Body, response.Flushed)
+	}
+}
+
+func TestDeadlineWriterWritesAfterClientGone(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Hour))
+	cancel()
+	response := httptest.NewRecorder()
+	w := &deadlineWriter{NewResponseRecorder(response), ctx}
+
+	w.WriteHeader(http.StatusTeapot)
+	if _, err := w.Write([]byte("body")); nil != err {
+		t.Errorf("expected write to succeed, got %v", err)
+	}
+	if err := w.FlushError(); nil != err {
+		t.Errorf("expected flush to succeed, got %v", err)
+	}
+	if response.Code != http.StatusTeapot || response.Body.String() != "body" || !response.Flushed {
+		t.Errorf("expected response to be written, got status %d, body %q, flushed %t", response.Code, response.Body, response.Flushed)
+	}
+}
+
+func TestStepTimeoutCallsCatchWithErrTimeout(t *testing.T) {
+	var caught error
+	called := 0
+	handler := Chain2(func(response http.ResponseWriter, request *http.Request) (int, error) {
+		<-request.Context().Done()
+		_, _ = response.Write([]byte("late"))
+		return 0, nil
+	}, func(http.ResponseWriter, *http.Request, int) error {
+		called++
+		return nil
+	}).With(StepTimeout(1, time.Millisecond)).Finally(func(response http.ResponseWriter, _ *http.Request, err error) {
+		caught = err
+		response.WriteHeader(http.StatusGatewayTimeout)
+	})
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	if !errors.Is(caught, ErrTimeout) || !errors.Is(caught, context.DeadlineExceeded) {
+		t.Errorf("expected error to match %v, and %v, got %v", ErrTimeout, context.DeadlineExceeded, caught)
+	}
+	var timeoutErr *TimeoutError
+	if !errors.As(caught, &timeoutErr) || timeoutErr.Step != 1 {
+		t.Errorf("expected timeout at step 1, got %v", caught)
+	}
+	if called != 0 {
+		t.Errorf("expected second function not to be called, got %d calls", called)
+	}
+	if response.Code != http.StatusGatewayTimeout || response.Body.Len() != 0 {
+		t.Errorf("expected status %d, and empty body, got %d, and %q", http.StatusGatewayTimeout, response.Code, response.Body)
+	}
+}
+
+func TestStepTimeoutOnlyBoundsItsStep(t *testing.T) {
+	handler := Chain2(func(http.ResponseWriter, *http.Request) (int, error) {
+		return 0, nil
+	}, func(_ http.ResponseWriter, request *http.Request, _ int) error {
+		if _, ok := request.Context().Deadline(); ok {
+			t.Error("expected second function to have no deadline")
+		}
+		return nil
+	}).With(StepTimeout(1, time.Hour)).Finally(func(_ http.ResponseWriter, _ *http.Request, err error) {
+		t.Errorf("expected catch not to be called, got %v", err)
+	})
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
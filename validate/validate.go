@@ -0,0 +1,426 @@
+// Package validate validates values against rules declared via "validate" struct tags, e.g., `validate:"required,min=3"`, reporting every field that violates them at once.
+//
+// Rules of a field are separated by commas, and are checked in order:
+//   - required: the field must not be empty, i.e., the zero value of its type, or an empty slice, or map,
+//   - omitempty: other rules of the field are not checked if it is empty, which makes optional fields valid if they are not set,
+//   - min=N, max=N: numbers must not be less, or greater than N respectively, and strings, slices, arrays, and maps must not have less, or more than N characters, or items,
+//   - len=N: strings, slices, arrays, and maps must have exactly N characters, or items,
+//   - oneof=A B C: strings, and integers must be equal to one of the space-separated values,
+//   - regexp=EXPR: strings must match the regular expression EXPR. As EXPR can contain commas, it must be the last rule of the field,
+//   - email: strings must be a valid email address without a display name, e.g., "user@example.com".
+//
+// Rules are checked even if the field is empty, unless the field has the omitempty rule, e.g., `validate:"min=18"` rejects 0, and `validate:"omitempty,min=18"` accepts it. Rules of nil pointers are checked against the zero value of the type they point to. Fields of nested structs, and items of slices, arrays, and maps are validated as well, regardless of whether the field has any rule. Fields are reported by their json struct tag names, if there are any.
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/xeptore/middle/v6"
+	"github.com/xeptore/middle/v6/internal/wrap"
+)
+
+// FieldError describes a rule a field violates.
+type FieldError struct {
+	// Field is the path of the field, e.g., "address.city", or "items[0].name".
+	Field string `json:"field"`
+	// Rule is the name of the rule the field violates, e.g., "min".
+	Rule string `json:"rule"`
+	// Param is the parameter of the rule, e.g., "3" for "min=3", if it has any.
+	Param string `json:"param,omitempty"`
+	// Message describes the violation, e.g., "must be at least 3 characters long".
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return "value " + e.Message
+	}
+	return e.Field + " " + e.Message
+}
+
+// ValidationError lists every rule fields of a value violate.
+type ValidationError struct {
+	Fields []FieldError `json:"fields"`
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Error()
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// Check validates v, and returns a [*ValidationError] if it violates any rule. A nil pointer to a struct violates the required rule, with an empty field path, as there are no fields to validate, e.g., if a JSON request body is null. It panics if type of v has an invalid rule.
+func Check(v any) error {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return nil
+	}
+	if nilStruct(value) {
+		return &ValidationError{Fields: []FieldError{{Rule: "required", Message: "is required"}}}
+	}
+	var fields []FieldError
+	validate(value, "", &fields)
+	if len(fields) > 0 {
+		return &ValidationError{Fields: fields}
+	}
+	return nil
+}
+
+// Validate creates a function that validates result of the previous function call, and returns it unchanged, so it can be used in chains, e.g., via [middle.LiftLast3], or pipes. It fails with an [*middle.HTTPError] of [net/http.StatusUnprocessableEntity] status wrapping a [*ValidationError] if the value violates any rule, so catch callbacks can render the field errors via [errors.As]. It panics if T has an invalid rule.
+func Validate[T any]() func(http.ResponseWriter, *http.Request, T) (T, error) {
+	compile(reflect.TypeOf((*T)(nil)).Elem())
+	return func(_ http.ResponseWriter, _ *http.Request, t T) (T, error) {
+		return t, httpError(Check(t))
+	}
+}
+
+// Decode wraps decode, e.g., a function created via [middle.DecodeJSON], so that the decoded value is validated the same way functions created via [Validate] do. Errors decode fails with are returned as is. The wrapper is recorded as such, so chains registered on a [middle.Router] describe it by decode in their [middle.StepInfo], which lets the openapi subpackage recognize decode as a body decoder.
+func Decode[T any](decode func(http.ResponseWriter, *http.Request) (T, error)) func(http.ResponseWriter, *http.Request) (T, error) {
+	compile(reflect.TypeOf((*T)(nil)).Elem())
+	return wrap.Func(func(response http.ResponseWriter, request *http.Request) (t T, err error) {
+		if wrap.Report(request, decode) {
+			return t, nil
+		}
+		t, err = decode(response, request)
+		if nil != err {
+			return t, err
+		}
+		return t, httpError(Check(t))
+	})
+}
+
+func httpError(err error) error {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return &middle.HTTPError{Status: http.StatusUnprocessableEntity, Err: validationErr}
+	}
+	return err
+}
+
+// nilStruct reports whether v is a nil pointer, or a pointer to a nil pointer, and so on, to a struct.
+func nilStruct(v reflect.Value) bool {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			typ := v.Type()
+			for typ.Kind() == reflect.Pointer {
+				typ = typ.Elem()
+			}
+			return typ.Kind() == reflect.Struct
+		}
+		v = v.Elem()
+	}
+	return false
+}
+
+// validate validates v, whose path is path, appending rules it violates to fields.
+func validate(v reflect.Value, path string, fields *[]FieldError) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			validate(v.Elem(), path, fields)
+		}
+	case reflect.Struct:
+		for _, field := range compile(v.Type()).fields {
+			field.validate(v.Field(field.index), join(path, field.name), fields)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validate(v.Index(i), fmt.Sprintf("%s[%d]", path, i), fields)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			validate(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()), fields)
+		}
+	}
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// rule is a compiled rule of a field.
+type rule struct {
+	name, param string
+	message     string
+	// valid reports whether the field value, with pointers dereferenced, satisfies the rule.
+	valid func(reflect.Value) bool
+}
+
+// field is a compiled struct field.
+type field struct {
+	index     int
+	name      string
+	required  bool
+	omitEmpty bool
+	rules     []rule
+}
+
+func (f field) validate(v reflect.Value, path string, fields *[]FieldError) {
+	if empty(v) {
+		if f.required {
+			*fields = append(*fields, FieldError{Field: path, Rule: "required", Message: "is required"})
+			return
+		}
+		if f.omitEmpty {
+			return
+		}
+	}
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			if v.Kind() == reflect.Pointer {
+				// There is nothing to validate recursively, but rules are checked against the zero value, the same way they are for other empty fields.
+				f.check(reflect.Zero(v.Type().Elem()), path, fields)
+			}
+			return
+		}
+		v = v.Elem()
+	}
+	f.check(v, path, fields)
+	validate(v, path, fields)
+}
+
+// check appends rules of the field that v, the field value with pointers dereferenced, violates to fields.
+func (f field) check(v reflect.Value, path string, fields *[]FieldError) {
+	for v.Kind() == reflect.Pointer {
+		v = reflect.Zero(v.Type().Elem())
+	}
+	for _, r := range f.rules {
+		if !r.valid(v) {
+			*fields = append(*fields, FieldError{Field: path, Rule: r.name, Param: r.param, Message: r.message})
+		}
+	}
+}
+
+func empty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// plan is a compiled struct type.
+type plan struct {
+	fields []field
+}
+
+var (
+	// plansMu serializes compilation of plans, so each type is compiled once.
+	plansMu sync.Mutex
+	// plans holds compiled plans by their struct types. It only holds plans whose fields are compiled, so validation can read it without locking.
+	plans sync.Map
+)
+
+// compile compiles rules of typ, and types it consists of, if it has not been compiled yet, and returns plan of typ, if it is a struct type. It panics if any of the types has an invalid rule.
+func compile(typ reflect.Type) *plan {
+	if p, ok := plans.Load(typ); ok {
+		return p.(*plan)
+	}
+	plansMu.Lock()
+	defer plansMu.Unlock()
+	compiled := make(map[reflect.Type]*plan)
+	p := compileLocked(typ, compiled)
+	for typ, p := range compiled {
+		plans.Store(typ, p)
+	}
+	return p
+}
+
+// compileLocked compiles typ, the same way compile does, adding plans of types that were not compiled before to compiled, rather than plans, as their fields are being compiled. plansMu must be held.
+func compileLocked(typ reflect.Type, compiled map[reflect.Type]*plan) *plan {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		compileLocked(typ.Elem(), compiled)
+		return nil
+	case reflect.Struct:
+	default:
+		return nil
+	}
+	if p, ok := plans.Load(typ); ok {
+		return p.(*plan)
+	}
+	if p, ok := compiled[typ]; ok {
+		return p
+	}
+	p := new(plan)
+	// The plan is added before its fields are compiled, so recursive types refer to it.
+	compiled[typ] = p
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		compileLocked(sf.Type, compiled)
+		f := field{index: i, name: fieldName(sf)}
+		tag := sf.Tag.Get("validate")
+		for tag != "" {
+			var spec string
+			if strings.HasPrefix(tag, "regexp=") {
+				spec, tag = tag, ""
+			} else {
+				spec, tag, _ = strings.Cut(tag, ",")
+			}
+			switch spec {
+			case "required":
+				f.required = true
+				continue
+			case "omitempty":
+				f.omitEmpty = true
+				continue
+			}
+			f.rules = append(f.rules, compileRule(typ, sf, spec))
+		}
+		p.fields = append(p.fields, f)
+	}
+	return p
+}
+
+// fieldName returns name of sf as it is encoded by [encoding/json].
+func fieldName(sf reflect.StructField) string {
+	if name, _, _ := strings.Cut(sf.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	return sf.Name
+}
+
+// compileRule compiles rule spec of field sf of struct type typ.
+func compileRule(typ reflect.Type, sf reflect.StructField, spec string) rule {
+	name, param, _ := strings.Cut(spec, "=")
+	r := rule{name: name, param: param}
+	fieldType := sf.Type
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	kind := fieldType.Kind()
+	invalid := func(reason string) {
+		panic(fmt.Sprintf("validate: invalid rule %q of field %s of %s: %s", spec, sf.Name, typ, reason))
+	}
+	switch name {
+	case "min", "max", "len":
+		n, err := strconv.ParseFloat(param, 64)
+		if nil != err {
+			invalid("parameter is not a number")
+		}
+		switch kind {
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+			unit := "items"
+			if kind == reflect.String {
+				unit = "characters long"
+			}
+			r.message, r.valid = lengthRule(name, param, unit, n)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if name == "len" {
+				invalid("numbers do not have a length")
+			}
+			r.message, r.valid = numberRule(name, param, n)
+		default:
+			invalid("field type is not supported")
+		}
+	case "oneof":
+		values := strings.Fields(param)
+		switch kind {
+		case reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			invalid("field type is not supported")
+		}
+		r.message = "must be one of " + strings.Join(values, ", ")
+		r.valid = func(v reflect.Value) bool {
+			s := text(v)
+			for _, value := range values {
+				if s == value {
+					return true
+				}
+			}
+			return false
+		}
+	case "regexp":
+		if kind != reflect.String {
+			invalid("field type is not supported")
+		}
+		re, err := regexp.Compile(param)
+		if nil != err {
+			invalid(err.Error())
+		}
+		r.message = "must match " + param
+		r.valid = func(v reflect.Value) bool { return re.MatchString(v.String()) }
+	case "email":
+		if kind != reflect.String {
+			invalid("field type is not supported")
+		}
+		r.message = "must be a valid email address"
+		r.valid = func(v reflect.Value) bool {
+			address, err := mail.ParseAddress(v.String())
+			return nil == err && address.Name == "" && address.Address == v.String()
+		}
+	default:
+		invalid("unknown rule")
+	}
+	return r
+}
+
+func lengthRule(name, param, unit string, n float64) (string, func(reflect.Value) bool) {
+	length := func(v reflect.Value) float64 {
+		if v.Kind() == reflect.String {
+			return float64(utf8.RuneCountInString(v.String()))
+		}
+		return float64(v.Len())
+	}
+	switch name {
+	case "min":
+		return fmt.Sprintf("must be at least %s %s", param, unit), func(v reflect.Value) bool { return length(v) >= n }
+	case "max":
+		return fmt.Sprintf("must be at most %s %s", param, unit), func(v reflect.Value) bool { return length(v) <= n }
+	default:
+		return fmt.Sprintf("must be exactly %s %s", param, unit), func(v reflect.Value) bool { return length(v) == n }
+	}
+}
+
+func numberRule(name, param string, n float64) (string, func(reflect.Value) bool) {
+	if name == "min" {
+		return "must be at least " + param, func(v reflect.Value) bool { return number(v) >= n }
+	}
+	return "must be at most " + param, func(v reflect.Value) bool { return number(v) <= n }
+}
+
+func number(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+// text formats v, which must be a string, or an integer, the same way its values are written in oneof rules.
+func text(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	default:
+		return strconv.FormatInt(v.Int(), 10)
+	}
+}
@@ -0,0 +1,155 @@
+package validate
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/xeptore/middle/v6"
+)
+
+func rules(err error) []string {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	var rules []string
+	for _, field := range validationErr.Fields {
+		rules = append(rules, field.Field+":"+field.Rule)
+	}
+	return rules
+}
+
+func TestCheckAppliesRulesToZeroValues(t *testing.T) {
+	type value struct {
+		Age    int      `json:"age" validate:"min=18"`
+		Choice int      `json:"choice" validate:"oneof=1 2 3"`
+		Items  []string `json:"items" validate:"min=1"`
+		Email  string   `json:"email" validate:"email"`
+		Score  *int     `json:"score" validate:"min=1"`
+	}
+	got := rules(Check(value{}))
+	expected := []string{"age:min", "choice:oneof", "items:min", "email:email", "score:min"}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, got)
+			break
+		}
+	}
+}
+
+func TestCheckSkipsEmptyFieldsWithOmitEmpty(t *testing.T) {
+	type value struct {
+		Age   int      `validate:"omitempty,min=18"`
+		Items []string `validate:"omitempty,min=2"`
+		Email *string  `validate:"omitempty,email"`
+	}
+	if err := Check(value{}); nil != err {
+		t.Errorf("expected empty fields to be valid, got %v", err)
+	}
+	invalid := "invalid"
+	if got := rules(Check(value{Age: 1, Items: []string{"a"}, Email: &invalid})); len(got) != 3 {
+		t.Errorf("expected non-empty fields to be checked, got %v", got)
+	}
+}
+
+func TestCheckRequired(t *testing.T) {
+	type value struct {
+		Name string `json:"name" validate:"required,omitempty,min=3"`
+	}
+	if got := rules(Check(value{})); len(got) != 1 || got[0] != "name:required" {
+		t.Errorf("expected name to be required, got %v", got)
+	}
+	if got := rules(Check(value{Name: "ab"})); len(got) != 1 || got[0] != "name:min" {
+		t.Errorf("expected name to be too short, got %v", got)
+	}
+}
+
+func TestCheckSkipsNestedNilPointers(t *testing.T) {
+	type address struct {
+		City string `validate:"required"`
+	}
+	type value struct {
+		Address *address
+	}
+	if err := Check(value{}); nil != err {
+		t.Errorf("expected nil nested struct to be valid, got %v", err)
+	}
+	if got := rules(Check(value{Address: &address{}})); len(got) != 1 || got[0] != "Address.City:required" {
+		t.Errorf("expected nested struct to be validated, got %v", got)
+	}
+}
+
+func TestCheckRequiresNilRootStructs(t *testing.T) {
+	type value struct {
+		Name string `validate:"omitempty"`
+	}
+	if got := rules(Check((*value)(nil))); len(got) != 1 || got[0] != ":required" {
+		t.Errorf("expected nil value to be required, got %v", got)
+	}
+	if got := rules(Check((**value)(nil))); len(got) != 1 || got[0] != ":required" {
+		t.Errorf("expected nil pointer to pointer to be required, got %v", got)
+	}
+	if err := Check((*int)(nil)); nil != err {
+		t.Errorf("expected nil non-struct pointer to be valid, got %v", err)
+	}
+}
+
+func TestDecodeRejectsNullBody(t *testing.T) {
+	type value struct {
+		Name string `json:"name"`
+	}
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("null"))
+	request.Header.Set("Content-Type", "application/json")
+	v, err := Decode(middle.DecodeJSON[*value](middle.JSONOptions{}))(httptest.NewRecorder(), request)
+	var httpErr *middle.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != http.StatusUnprocessableEntity {
+		t.Fatalf("expected an *middle.HTTPError of status %d, got %v", http.StatusUnprocessableEntity, err)
+	}
+	if got := rules(err); len(got) != 1 || got[0] != ":required" {
+		t.Errorf("expected value to be required, got %v", got)
+	}
+	if nil != v {
+		t.Errorf("expected no value, got %v", v)
+	}
+}
+
+type node struct {
+	Name     string  `validate:"required"`
+	Children []*node `validate:"omitempty,max=2"`
+}
+
+func TestCheckRecursiveTypesConcurrently(t *testing.T) {
+	done := make(chan []string)
+	for i := 0; i < 8; i++ {
+		go func() {
+			done <- rules(Check(node{Name: "root", Children: []*node{{}}}))
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		if got := <-done; len(got) != 1 || got[0] != "Children[0].Name:required" {
+			t.Errorf("expected nested name to be required, got %v", got)
+		}
+	}
+}
+
+func TestCompilePanicsOnInvalidRuleWithoutCachingPlan(t *testing.T) {
+	type invalid struct {
+		Name string `validate:"min=x"`
+	}
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				if nil == recover() {
+					t.Error("expected a panic")
+				}
+			}()
+			_ = Check(invalid{})
+		}()
+	}
+}
@@ -0,0 +1,20 @@
+package middle
+
+import (
+	"context"
+	"net/http"
+)
+
+// valueKey is the context key results of chain functions are stored under by middlewares created via [ChainPrefix1.Middleware], and the like.
+type valueKey[T any] struct{}
+
+// withValue returns a copy of ctx that holds v under the key of its type.
+func withValue[T any](ctx context.Context, v T) context.Context {
+	return context.WithValue(ctx, valueKey[T]{}, v)
+}
+
+// Value returns the value of type T stored in the request context by a middleware created via [ChainPrefix1.Middleware], and the like, and reports whether there is any. Values are stored under the key of their type, so if a prefix has multiple functions with the same result type, only result of the last one is accessible.
+func Value[T any](request *http.Request) (T, bool) {
+	v, ok := request.Context().Value(valueKey[T]{}).(T)
+	return v, ok
+}